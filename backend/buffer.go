@@ -0,0 +1,44 @@
+package backend
+
+import "sync"
+
+// bufferSizes are the size classes of pooled encoder buffers.
+var bufferSizes = [...]int{1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20}
+
+// maxBufferSize is the capacity of the largest buffer kept in pools.
+var maxBufferSize = 2 * bufferSizes[len(bufferSizes)-1]
+
+// bufferPools holds released encoders by the size class of their buffers.
+var bufferPools [len(bufferSizes)]sync.Pool
+
+// poisonByte overwrites released buffers in debug builds.
+const poisonByte = 0xdd
+
+// encodedSize is the length of the data encoded recently, used to choose the size class of new encoders.
+var encodedSize int64
+
+// sizeClass returns the smallest size class which can hold n bytes.
+func sizeClass(n int) int {
+	for i, size := range bufferSizes {
+		if n <= size {
+			return i
+		}
+	}
+
+	return len(bufferSizes) - 1
+}
+
+// capClass returns the largest size class not larger than c, or -1 if c is too small or too large to be pooled.
+func capClass(c int) int {
+	if c > maxBufferSize {
+		return -1
+	}
+
+	for i := len(bufferSizes) - 1; i >= 0; i-- {
+		if c >= bufferSizes[i] {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,51 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEncoderBuffer(t *testing.T) {
+	dst := make([]byte, 0, 64)
+	dst = append(dst, "data:"...)
+
+	encoder := NewEncoderBuffer(dst)
+	encoder.EncodeString("gojson")
+	data := encoder.Bytes()
+	encoder.Release()
+
+	assert.Equal(t, `data:"gojson"`, string(data), "data must be equal to the value expected")
+	assert.Equal(t, &dst[0], &data[0], "data must be appended to dst")
+
+	// dst must not be reused by pooled encoders
+	encoder = NewEncoder()
+	encoder.EncodeString("other")
+	encoder.Release()
+	assert.Equal(t, `data:"gojson"`, string(data), "data must not be changed after Release")
+}
+
+func TestEncoderAllocs(t *testing.T) {
+	dst := make([]byte, 0, 1024)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		encoder := NewEncoder()
+		encoder.EncodeKeyString("name", "gojson")
+		encoder.Release()
+
+		encoder = NewEncoderBuffer(dst[:0])
+		encoder.EncodeKeyString("name", "gojson")
+		encoder.Release()
+	})
+	assert.Equal(t, float64(0), allocs, "allocs must be zero")
+}
+
+func TestSizeClass(t *testing.T) {
+	assert.Equal(t, 0, sizeClass(0), "class must be equal to the value expected")
+	assert.Equal(t, 1, sizeClass(2000), "class must be equal to the value expected")
+	assert.Equal(t, len(bufferSizes)-1, sizeClass(8<<20), "class must be equal to the value expected")
+
+	assert.Equal(t, -1, capClass(100), "class must be equal to the value expected")
+	assert.Equal(t, 1, capClass(5000), "class must be equal to the value expected")
+	assert.Equal(t, -1, capClass(8<<20), "class must be equal to the value expected")
+}
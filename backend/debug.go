@@ -0,0 +1,7 @@
+//go:build gojsondebug
+
+package backend
+
+// debug is set by the gojsondebug build tag, eg: go test -tags gojsondebug ./...,
+// buffers owned by decoders are poisoned on Reset and Release to detect use after release.
+const debug = true
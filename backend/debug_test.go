@@ -0,0 +1,31 @@
+//go:build gojsondebug
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoderPoison(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.Reset([]byte(`"gojson"`))
+
+	s, err := decoder.DecodeString()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "gojson", s, "s must be equal to the value expected")
+
+	// s aliases the buffer of decoder, which is poisoned on Release
+	decoder.Release()
+	assert.Equal(t, "\xdd\xdd\xdd\xdd\xdd\xdd", s, "s must be poisoned after Release")
+}
+
+func TestEncoderPoison(t *testing.T) {
+	encoder := NewEncoder()
+	encoder.EncodeString("gojson")
+
+	data := encoder.Bytes()
+	encoder.Release()
+	assert.Equal(t, "\xdd\xdd\xdd\xdd\xdd\xdd\xdd\xdd", string(data), "data must be poisoned after Release")
+}
@@ -6,24 +6,105 @@ import (
 	"github.com/go-fish/gojson/errors"
 )
 
+type numberMode uint8
+
+const (
+	numberFloat64 numberMode = iota
+	numberUseNumber
+	numberUseInt64
+)
+
 type Decoder struct {
-	data   []byte
-	cursor int
-	length int
-	err    error
+	data       []byte
+	cursor     int
+	length     int
+	err        error
+	numberMode numberMode
+	unsafe     bool
+
+	// buf holds the copy of data made by Reset, which is reused by later calls of Reset.
+	buf []byte
+	// owned is set if data is buf, strings decoded alias it and are invalid after Reset or Release.
+	owned bool
+
+	limits        Limits
+	depth         int
+	duplicateKeys DuplicateKeys
+
+	// tokenState is the position of Token in the value, tokenStack holds the states of the enclosing values.
+	tokenState tokenState
+	tokenStack []tokenState
+}
+
+// DecoderOption configures the decoder in Reset.
+type DecoderOption func(d *Decoder)
+
+// WithUnsafe makes the decoder alias the data instead of copying it, see SetUnsafeData.
+func WithUnsafe() DecoderOption {
+	return func(d *Decoder) { d.unsafe = true }
+}
+
+// WithNumber makes the decoder decode numbers into interface{} values as Number, see UseNumber.
+func WithNumber() DecoderOption {
+	return func(d *Decoder) { d.numberMode = numberUseNumber }
+}
+
+// WithInt64 makes the decoder decode integral numbers into interface{} values as int64, see UseInt64.
+func WithInt64() DecoderOption {
+	return func(d *Decoder) { d.numberMode = numberUseInt64 }
+}
+
+// WithLimits sets the limits of the decoder, the size of data is checked by CheckSize.
+func WithLimits(limits Limits) DecoderOption {
+	return func(d *Decoder) { d.limits = limits }
 }
 
 var decoderPool = &sync.Pool{New: func() interface{} { return new(Decoder) }}
 
 func NewDecoder() *Decoder {
-	return decoderPool.Get().(*Decoder)
+	d := decoderPool.Get().(*Decoder)
+	d.limits = DefaultLimits
+	d.duplicateKeys = DefaultDuplicateKeys
+
+	return d
 }
 
 func (d *Decoder) reset() {
+	d.poison()
+
 	d.data = nil
 	d.cursor = 0
 	d.length = 0
 	d.err = nil
+	d.numberMode = numberFloat64
+	d.unsafe = false
+	d.owned = false
+	d.limits = DefaultLimits
+	d.depth = 0
+	d.duplicateKeys = DefaultDuplicateKeys
+	d.resetToken()
+}
+
+// Reset prepares the decoder to decode data with opts, the data is copied into a buffer which is owned by the
+// decoder and reused by later calls of Reset, so decoding many documents does not allocate a copy for each.
+// strings and numbers decoded alias the buffer and are only valid until the next Reset or Release,
+// use SetData instead if they are kept.
+func (d *Decoder) Reset(data []byte, opts ...DecoderOption) {
+	d.reset()
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	if d.unsafe {
+		d.data = data
+	} else {
+		d.buf = append(d.buf[:0], data...)
+		d.data = d.buf
+		d.owned = true
+	}
+
+	d.length = len(data)
 }
 
 func (d *Decoder) SetData(data []byte) {
@@ -33,19 +114,53 @@ func (d *Decoder) SetData(data []byte) {
 
 	d.length = len(data)
 	d.cursor = 0
+	d.depth = 0
+	d.resetToken()
 }
 
 func (d *Decoder) SetUnsafeData(data []byte) {
 	d.data = data
 	d.length = len(data)
 	d.cursor = 0
+	d.depth = 0
+	d.resetToken()
+}
+
+// UseNumber makes DecodeValue, DecodeObject and DecodeArray decode numbers as Number instead of float64.
+func (d *Decoder) UseNumber() {
+	d.numberMode = numberUseNumber
 }
 
+// UseInt64 makes DecodeValue, DecodeObject and DecodeArray decode integral numbers as int64,
+// numbers which are not integral or overflow int64 are decoded as Number.
+func (d *Decoder) UseInt64() {
+	d.numberMode = numberUseInt64
+}
+
+// Release puts the decoder back to the pool, values decoded after Reset are invalid after Release.
 func (d *Decoder) Release() {
 	d.reset()
+
+	// buffers grown too large are left to gc instead of pinning memory in the pool
+	if cap(d.buf) > maxBufferSize {
+		d.buf = nil
+	}
+
 	decoderPool.Put(d)
 }
 
+// poison overwrites the buffer owned by the decoder in debug builds, so that values still aliasing it
+// after Reset or Release are detected by tests instead of being silently changed by the next decode.
+func (d *Decoder) poison() {
+	if !debug || !d.owned {
+		return
+	}
+
+	for i := range d.buf {
+		d.buf[i] = poisonByte
+	}
+}
+
 func (d *Decoder) Need(b byte) bool {
 	for d.cursor < d.length {
 		switch d.data[d.cursor] {
@@ -131,6 +246,38 @@ func (d *Decoder) AssetTrue() (bool, error) {
 	return false, errors.NewParseError(d.data[d.cursor], d.cursor)
 }
 
+// CheckEnd returns an error if anything other than whitespace follows the value decoded,
+// it is called after the root value to reject trailing data, eg: {"a":1}garbage.
+func (d *Decoder) CheckEnd() error {
+	if d.skipSpace() {
+		return errors.NewParseError(d.data[d.cursor], d.cursor)
+	}
+
+	return nil
+}
+
+// More reports whether another element follows in the current array or object, or another value follows
+// at the top level, it is used to decode concatenated documents or the elements of an array opened by Token, eg:
+//
+//	for dec.More() {
+//		if err := v.DecodeFrom(dec); err != nil {
+//			return err
+//		}
+//	}
+func (d *Decoder) More() bool {
+	if !d.skipSpace() {
+		return false
+	}
+
+	c := d.data[d.cursor]
+	return c != ']' && c != '}'
+}
+
+// InputOffset returns the offset in data right after the value decoded.
+func (d *Decoder) InputOffset() int {
+	return d.cursor
+}
+
 func (d *Decoder) Cursor() int {
 	return d.cursor
 }
@@ -138,3 +285,15 @@ func (d *Decoder) Cursor() int {
 func (d *Decoder) Char() byte {
 	return d.data[d.cursor]
 }
+
+func (d *Decoder) parseError() error {
+	if d.cursor < d.length {
+		return errors.NewParseError(d.data[d.cursor], d.cursor)
+	}
+
+	if d.length > 0 {
+		return errors.NewParseError(d.data[d.length-1], d.length-1)
+	}
+
+	return errors.NewParseError(0, 0)
+}
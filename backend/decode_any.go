@@ -0,0 +1,54 @@
+package backend
+
+import "encoding/json"
+
+// DecodeAny decodes the next value into v, which must be a pointer, null leaves v unchanged.
+// it is used for values whose types are unknown at generation time, eg: fields of type parameters,
+// types which are not supported by decoder are decoded by encoding/json.
+func (d *Decoder) DecodeAny(v interface{}) error {
+	if d.IsNull() {
+		return nil
+	}
+
+	var err error
+
+	switch x := v.(type) {
+	case *interface{}:
+		*x, err = d.DecodeValue()
+
+	case *string:
+		*x, err = d.DecodeString()
+
+	case *bool:
+		*x, err = d.DecodeBool()
+
+	case *int:
+		*x, err = d.DecodeInt()
+
+	case *int64:
+		*x, err = d.DecodeInt64()
+
+	case *float64:
+		*x, err = d.DecodeFloat64()
+
+	case *Number:
+		*x, err = d.DecodeNumber()
+
+	case Unmarshaler:
+		return x.DecodeFrom(d)
+
+	default:
+		data, err := d.ReadValue()
+		if err != nil {
+			return err
+		}
+
+		if u, ok := v.(json.Unmarshaler); ok {
+			return u.UnmarshalJSON(data)
+		}
+
+		return json.Unmarshal(data, v)
+	}
+
+	return err
+}
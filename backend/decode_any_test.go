@@ -0,0 +1,113 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type anyPoint struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+func TestDecodeAny(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(`"gojson"`))
+	defer decoder.Release()
+
+	var s string
+	err := decoder.DecodeAny(&s)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "gojson", s, "s must be equal to the value expected")
+}
+
+func TestDecodeAnyFallback(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(`[{"x":1,"y":2},null]`))
+	defer decoder.Release()
+
+	var v []*anyPoint
+	err := decoder.DecodeAny(&v)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, []*anyPoint{{1, 2}, nil}, v, "v must be equal to the value expected")
+}
+
+func TestDecodeAnyNull(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(`null`))
+	defer decoder.Release()
+
+	i := 7
+	err := decoder.DecodeAny(&i)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, 7, i, "i must be unchanged")
+}
+
+func TestEncodeAny(t *testing.T) {
+	encoder := NewEncoder()
+	defer encoder.Release()
+
+	encoder.WriteByte('[')
+	assert.Nil(t, encoder.EncodeAny(1), "Err must be nil")
+	encoder.WriteByte(',')
+	assert.Nil(t, encoder.EncodeAny(nil), "Err must be nil")
+	encoder.WriteByte(',')
+	assert.Nil(t, encoder.EncodeAny(anyPoint{1, 2}), "Err must be nil")
+	encoder.WriteByte(']')
+
+	assert.Equal(t, `[1,null,{"x":1,"y":2}]`, string(encoder.Bytes()), "data must be equal to the value expected")
+}
+
+type goLabel struct {
+	Name string
+}
+
+func (l *goLabel) EncodeTo(enc *Encoder) error {
+	enc.EncodeString(l.Name)
+	return nil
+}
+
+func TestEncodeAnyPointer(t *testing.T) {
+	encoder := NewEncoder()
+	defer encoder.Release()
+
+	i, s, l := 1, "a", goLabel{"b"}
+
+	encoder.WriteByte('[')
+	assert.Nil(t, encoder.EncodeAny(&i), "Err must be nil")
+	encoder.WriteByte(',')
+	assert.Nil(t, encoder.EncodeAny(&s), "Err must be nil")
+	encoder.WriteByte(',')
+	assert.Nil(t, encoder.EncodeAny(&l), "Err must be nil")
+	encoder.WriteByte(',')
+	assert.Nil(t, encoder.EncodeAny(&anyPoint{1, 2}), "Err must be nil")
+	encoder.WriteByte(']')
+
+	assert.Equal(t, `[1,"a","b",{"x":1,"y":2}]`, string(encoder.Bytes()), "data must be equal to the value expected")
+}
+
+type goPoint struct {
+	X int
+}
+
+func (p *goPoint) DecodeFrom(dec *Decoder) error {
+	x, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+
+	p.X = x
+	return nil
+}
+
+func TestDecodeAnyUnmarshaler(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(`42`))
+	defer decoder.Release()
+
+	p := new(goPoint)
+	err := decoder.DecodeAny(p)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, 42, p.X, "p.X must be equal to the value expected")
+}
@@ -13,6 +13,11 @@ func (d *Decoder) ReadArray() ([]byte, error) {
 
 	begin := d.cursor
 	arrayOpend := 1
+	depth := d.depth + 1
+	if err := d.checkDepth(depth); err != nil {
+		return nil, err
+	}
+
 	d.cursor++
 
 	for d.cursor < d.length {
@@ -25,9 +30,25 @@ func (d *Decoder) ReadArray() ([]byte, error) {
 		case '[':
 			d.cursor++
 			arrayOpend++
+			depth++
+			if err := d.checkDepth(depth); err != nil {
+				return nil, err
+			}
+
+		case '{':
+			d.cursor++
+			depth++
+			if err := d.checkDepth(depth); err != nil {
+				return nil, err
+			}
+
+		case '}':
+			d.cursor++
+			depth--
 
 		case ']':
 			d.cursor++
+			depth--
 			arrayOpend--
 
 			if arrayOpend == 0 {
@@ -58,6 +79,10 @@ func (d *Decoder) DecodeArray() ([]interface{}, error) {
 		return nil, nil
 	}
 
+	if err := d.Enter(); err != nil {
+		return nil, err
+	}
+
 	for d.cursor < d.length {
 		value, err := d.DecodeValue()
 		if err != nil {
@@ -65,9 +90,13 @@ func (d *Decoder) DecodeArray() ([]interface{}, error) {
 		}
 
 		v = append(v, value)
+		if err := d.CheckElements(len(v)); err != nil {
+			return nil, err
+		}
 
 		if d.Need(']') {
 			d.cursor++
+			d.Leave()
 			return v, nil
 		}
 	}
@@ -77,6 +106,11 @@ func (d *Decoder) DecodeArray() ([]interface{}, error) {
 
 func (d *Decoder) SkipArray() error {
 	arrayOpend := 1
+	depth := d.depth + 1
+	if err := d.checkDepth(depth); err != nil {
+		return err
+	}
+
 	d.cursor++
 
 	for d.cursor < d.length {
@@ -89,9 +123,25 @@ func (d *Decoder) SkipArray() error {
 		case '[':
 			d.cursor++
 			arrayOpend++
+			depth++
+			if err := d.checkDepth(depth); err != nil {
+				return err
+			}
+
+		case '{':
+			d.cursor++
+			depth++
+			if err := d.checkDepth(depth); err != nil {
+				return err
+			}
+
+		case '}':
+			d.cursor++
+			depth--
 
 		case ']':
 			d.cursor++
+			depth--
 			arrayOpend--
 
 			if arrayOpend == 0 {
@@ -10,24 +10,11 @@ func (d *Decoder) ReadFloat() ([]byte, error) {
 		return nil, nil
 	}
 
-	data := d.data[d.cursor:]
 	begin := d.cursor
-
-	for _, c := range data {
-		if c == ']' ||
-			c == '}' ||
-			c == ',' {
-			goto End
-		} else if util.IsSkip(c) {
-			continue
-		} else if !util.IsFloat(c) {
-			return nil, errors.NewParseError(d.data[d.cursor], d.cursor)
-		}
-
-		d.cursor++
+	if err := d.SkipFloat64(); err != nil {
+		return nil, err
 	}
 
-End:
 	return d.data[begin:d.cursor], nil
 }
 
@@ -121,25 +108,12 @@ func (d *Decoder) SkipFloat32() error {
 }
 
 func (d *Decoder) SkipFloat64() error {
-	if d.Char() == '-' {
-		d.cursor++
-	}
-
-	data := d.data[d.cursor:]
-
-	for _, c := range data {
-		if c == ']' ||
-			c == '}' ||
-			c == ',' {
-			return nil
-		} else if util.IsSkip(c) {
-			continue
-		} else if !util.IsFloat(c) {
-			return errors.NewParseError(d.data[d.cursor], d.cursor)
-		}
-
-		d.cursor++
+	// numbers are scanned by the validator, so that exponents are skipped too, eg: 1e-3
+	v := &validator{data: d.data[:d.length], cursor: d.cursor}
+	if err := v.number(); err != nil {
+		return err
 	}
 
+	d.cursor = v.cursor
 	return nil
 }
@@ -55,3 +55,19 @@ func TestReadFloat(t *testing.T) {
 	assert.Nil(t, err, "Err must be nil")
 	assert.Equal(t, "127.11", string(data), "v must be equal to the value expected")
 }
+
+func TestReadFloatExponent(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte("[-1.5e-3, 2E+10]"))
+	defer decoder.Release()
+
+	decoder.Next()
+
+	data, err := decoder.ReadFloat()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "-1.5e-3", string(data), "v must be equal to the value expected")
+
+	decoder.NextChar()
+	assert.Nil(t, decoder.SkipFloat64(), "Err must be nil")
+	assert.Equal(t, byte(']'), decoder.Char(), "char must be equal to the value expected")
+}
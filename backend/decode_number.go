@@ -0,0 +1,52 @@
+package backend
+
+import (
+	"github.com/go-fish/gojson/util"
+)
+
+func (d *Decoder) DecodeNumber() (Number, error) {
+	if c := d.NextChar(); c == 'n' {
+		return "", d.AssetNull()
+	} else if c != '-' && !util.IsNumber(c) {
+		return "", d.parseError()
+	}
+
+	v := &validator{data: d.data[:d.length], cursor: d.cursor}
+	if err := v.number(); err != nil {
+		return "", err
+	}
+
+	n := Number(util.UnsafeConvertBytesToString(d.data[d.cursor:v.cursor]))
+	d.cursor = v.cursor
+
+	return n, nil
+}
+
+func (d *Decoder) SkipNumber() error {
+	_, err := d.DecodeNumber()
+	return err
+}
+
+// decodeNumberValue decodes a number for an interface{} value according to
+// the number mode of decoder.
+func (d *Decoder) decodeNumberValue() (interface{}, error) {
+	switch d.numberMode {
+	case numberUseNumber:
+		return d.DecodeNumber()
+
+	case numberUseInt64:
+		n, err := d.DecodeNumber()
+		if err != nil {
+			return nil, err
+		}
+
+		if v, err := n.Int64(); err == nil {
+			return v, nil
+		}
+
+		return n, nil
+
+	default:
+		return d.DecodeFloat64()
+	}
+}
@@ -0,0 +1,71 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeNumber(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(` -12.5e+3,`))
+	defer decoder.Release()
+
+	v, err := decoder.DecodeNumber()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, Number("-12.5e+3"), v, "v must be equal to the value expected")
+
+	f, err := v.Float64()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, -12500.0, f, "f must be equal to the value expected")
+}
+
+func TestDecodeNumberInvalid(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(`"1"`))
+	defer decoder.Release()
+
+	_, err := decoder.DecodeNumber()
+	assert.NotNil(t, err, "Err must not be nil")
+}
+
+func TestNumberBigInt(t *testing.T) {
+	v, err := Number("123456789012345678901234567890").BigInt()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "123456789012345678901234567890", v.String(), "v must be equal to the value expected")
+
+	_, err = Number("1.5").BigInt()
+	assert.NotNil(t, err, "Err must not be nil")
+}
+
+func TestDecodeObjectUseNumber(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(`{"id": 9007199254740993, "list": [1.5, 2]}`))
+	decoder.UseNumber()
+	defer decoder.Release()
+
+	v, err := decoder.DecodeObject()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, Number("9007199254740993"), v["id"], "v[id] must be equal to the value expected")
+	assert.Equal(t, []interface{}{Number("1.5"), Number("2")}, v["list"], "v[list] must be equal to the value expected")
+}
+
+func TestDecodeArrayUseInt64(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(`[9007199254740993, 1.5, 99999999999999999999]`))
+	decoder.UseInt64()
+	defer decoder.Release()
+
+	v, err := decoder.DecodeArray()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, []interface{}{int64(9007199254740993), Number("1.5"), Number("99999999999999999999")}, v, "v must be equal to the value expected")
+}
+
+func TestEncodeNumber(t *testing.T) {
+	encoder := NewEncoder()
+	defer encoder.Release()
+
+	assert.Nil(t, encoder.EncodeValue(Number("9007199254740993")), "Err must be nil")
+	assert.Equal(t, "9007199254740993", string(encoder.Bytes()), "data must be equal to the value expected")
+	assert.NotNil(t, encoder.EncodeNumber("1x"), "Err must not be nil")
+}
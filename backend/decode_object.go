@@ -10,34 +10,15 @@ func (d *Decoder) NextKey() (string, error) {
 		return "", errors.NewParseError(d.data[d.cursor], d.cursor)
 	}
 
-	d.cursor++
-	data := d.data[d.cursor:]
-
-	for i, c := range data {
-		if c == '"' {
-			d.cursor = d.cursor + i + 1
-
-			var found byte
-			for _, c := range data[i+1:] {
-				if c == ':' {
-					found |= 1
-					break
-				}
-
-				d.cursor++
-			}
-
-			if found&1 > 0 {
-				d.cursor++
-				return util.UnsafeConvertBytesToString(data[:i]), nil
-			}
+	key, err := d.readString()
+	if err != nil {
+		return "", err
+	}
 
-			return "", errors.NewParseError(d.data[d.cursor], d.cursor)
-		} else if c == '\\' {
-			if next := i + 1; next < len(data) && (data[next] == '\\' || data[next] == '"') {
-				// _ = append(data[:i], data[next:]...)
-				copy(data[i:], data[next:])
-			}
+	for ; d.cursor < d.length; d.cursor++ {
+		if d.data[d.cursor] == ':' {
+			d.cursor++
+			return util.UnsafeConvertBytesToString(key), nil
 		}
 	}
 
@@ -52,6 +33,11 @@ func (d *Decoder) ReadObject() ([]byte, error) {
 	}
 
 	objectOpened := 1
+	depth := d.depth + 1
+	if err := d.checkDepth(depth); err != nil {
+		return nil, err
+	}
+
 	begin := d.cursor
 	d.cursor++
 
@@ -65,9 +51,25 @@ func (d *Decoder) ReadObject() ([]byte, error) {
 		case '{':
 			d.cursor++
 			objectOpened++
+			depth++
+			if err := d.checkDepth(depth); err != nil {
+				return nil, err
+			}
+
+		case '[':
+			d.cursor++
+			depth++
+			if err := d.checkDepth(depth); err != nil {
+				return nil, err
+			}
+
+		case ']':
+			d.cursor++
+			depth--
 
 		case '}':
 			d.cursor++
+			depth--
 			objectOpened--
 
 			if objectOpened == 0 {
@@ -98,21 +100,35 @@ func (d *Decoder) DecodeObject() (map[string]interface{}, error) {
 		return nil, nil
 	}
 
+	if err := d.Enter(); err != nil {
+		return nil, err
+	}
+
 	for d.cursor < d.length {
 		key, err := d.NextKey()
 		if err != nil {
 			return nil, err
 		}
 
-		value, err := d.DecodeValue()
-		if err != nil {
+		if _, ok := v[key]; ok && d.duplicateKeys != AllowDuplicateKeys {
+			if _, err := d.duplicate(key); err != nil {
+				return nil, err
+			}
+		} else {
+			value, err := d.DecodeValue()
+			if err != nil {
+				return nil, err
+			}
+
+			v[key] = value
+		}
+		if err := d.CheckElements(len(v)); err != nil {
 			return nil, err
 		}
 
-		v[key] = value
-
 		if d.Need('}') {
 			d.cursor++
+			d.Leave()
 			return v, nil
 		}
 	}
@@ -122,6 +138,11 @@ func (d *Decoder) DecodeObject() (map[string]interface{}, error) {
 
 func (d *Decoder) SkipObject() error {
 	objectOpened := 1
+	depth := d.depth + 1
+	if err := d.checkDepth(depth); err != nil {
+		return err
+	}
+
 	d.cursor++
 
 	for d.cursor < d.length {
@@ -134,9 +155,25 @@ func (d *Decoder) SkipObject() error {
 		case '{':
 			d.cursor++
 			objectOpened++
+			depth++
+			if err := d.checkDepth(depth); err != nil {
+				return err
+			}
+
+		case '[':
+			d.cursor++
+			depth++
+			if err := d.checkDepth(depth); err != nil {
+				return err
+			}
+
+		case ']':
+			d.cursor++
+			depth--
 
 		case '}':
 			d.cursor++
+			depth--
 			objectOpened--
 
 			if objectOpened == 0 {
@@ -151,6 +188,70 @@ func (d *Decoder) SkipObject() error {
 	return errors.NewParseError(d.data[d.length-1], d.length-1)
 }
 
+// PeekString returns the string value of key in the object at cursor without moving the cursor,
+// an empty string is returned if the key is not found. Keys are compared unescaped and duplicate keys
+// follow the policy of the decoder like the keys decoded later.
+func (d *Decoder) PeekString(key string) (string, error) {
+	cursor := d.cursor
+	defer func() { d.cursor = cursor }()
+
+	if !d.IsObjectOpen() {
+		return "", d.parseError()
+	}
+
+	if d.IsObjectClose() {
+		return "", nil
+	}
+
+	var value string
+	var found bool
+
+	for d.cursor < d.length {
+		if d.NextChar() != '"' {
+			return "", d.parseError()
+		}
+
+		name, err := d.readString()
+		if err != nil {
+			return "", err
+		}
+
+		if !d.Need(':') {
+			return "", d.parseError()
+		}
+
+		d.cursor++
+
+		switch {
+		case string(name) != key || found && d.duplicateKeys == FirstDuplicateKeyWins:
+			if err := d.SkipValue(); err != nil {
+				return "", err
+			}
+
+		case found && d.duplicateKeys == RejectDuplicateKeys:
+			return "", errors.NewDuplicateKeyError(key, d.cursor)
+
+		default:
+			if d.NextChar() != '"' {
+				return "", d.parseError()
+			}
+
+			data, err := d.readString()
+			if err != nil {
+				return "", err
+			}
+
+			value, found = string(data), true
+		}
+
+		if d.IsObjectClose() {
+			return value, nil
+		}
+	}
+
+	return "", d.parseError()
+}
+
 func (d *Decoder) IsObjectOpen() bool {
 	if d.Need('{') {
 		d.cursor++
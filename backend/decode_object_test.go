@@ -3,6 +3,7 @@ package backend
 import (
 	"testing"
 
+	"github.com/go-fish/gojson/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,3 +36,61 @@ func TestReadObject(t *testing.T) {
 	assert.Nil(t, err, "Err must be nil")
 	assert.Equal(t, `{"key1": [["Test{{String1}","{TestString2}"],[123, 456],[123.123,456.456]], "key2": {"key3": "1111", "key4":123.111}}`, string(data), "data must be equal to the value expected")
 }
+
+func TestPeekString(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(` {"a": {"type": "x"}, "b": [1, "type"], "type": "Fo\"o"}`))
+	defer decoder.Release()
+
+	v, err := decoder.PeekString("type")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "Fo\"o", v, "v must be equal to the value expected")
+	assert.Equal(t, 0, decoder.Cursor(), "cursor must not move")
+
+	v, err = decoder.PeekString("missing")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "", v, "v must be empty")
+}
+
+func TestPeekStringEscaped(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(`{"\u0074ype": "Fo\u006f", "typ": "x"}`))
+	defer decoder.Release()
+
+	v, err := decoder.PeekString("type")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "Foo", v, "v must be equal to the value expected")
+}
+
+func TestPeekStringDuplicate(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	data := []byte(`{"type": "a", "b": 1, "type": "c"}`)
+
+	decoder.Reset(data)
+	v, err := decoder.PeekString("type")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "c", v, "v must be equal to the value expected")
+
+	decoder.Reset(data, WithDuplicateKeys(FirstDuplicateKeyWins))
+	v, err = decoder.PeekString("type")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "a", v, "v must be equal to the value expected")
+
+	decoder.Reset(data, WithDuplicateKeys(RejectDuplicateKeys))
+	_, err = decoder.PeekString("type")
+	assert.IsType(t, &errors.DuplicateKeyError{}, err, "Err must be DuplicateKeyError")
+}
+
+func TestEncodeTagged(t *testing.T) {
+	encoder := NewEncoder()
+	defer encoder.Release()
+
+	encoder.WriteByte('[')
+	encoder.EncodeTagged("type", "Foo", []byte(`{"a":1}`))
+	encoder.WriteComma()
+	encoder.EncodeTagged("type", "Bar", []byte(`{}`))
+	encoder.WriteByte(']')
+	assert.Equal(t, `[{"type":"Foo","a":1},{"type":"Bar"}]`, string(encoder.Bytes()), "data must be equal to the value expected")
+}
@@ -1,6 +1,11 @@
 package backend
 
 import (
+	"bytes"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
 	"github.com/go-fish/gojson/errors"
 	"github.com/go-fish/gojson/util"
 )
@@ -12,20 +17,19 @@ func (d *Decoder) ReadString() ([]byte, error) {
 		return nil, errors.NewParseError(d.data[d.cursor], d.cursor)
 	}
 
-	data := d.data[d.cursor:]
-
-	for i, c := range data {
-		if c == '"' && i != 0 {
-			if prev := i - 1; prev >= 0 && data[prev] == '\\' {
-				continue
-			}
+	end, _ := d.scanString()
+	if end < 0 {
+		return nil, errors.NewParseError(d.data[d.length-1], d.length-1)
+	}
 
-			d.cursor = d.cursor + i + 1
-			return data[:i+1], nil
-		}
+	if err := d.checkString(end - d.cursor - 1); err != nil {
+		return nil, err
 	}
 
-	return nil, errors.NewParseError(d.data[d.length-1], d.length-1)
+	data := d.data[d.cursor : end+1]
+	d.cursor = end + 1
+
+	return data, nil
 }
 
 func (d *Decoder) DecodeString() (string, error) {
@@ -35,38 +39,163 @@ func (d *Decoder) DecodeString() (string, error) {
 		return "", errors.NewParseError(d.data[d.cursor], d.cursor)
 	}
 
-	d.cursor++
-	data := d.data[d.cursor:]
-
-	for i, c := range data {
-		if c == '"' {
-			d.cursor = d.cursor + i + 1
-			return util.UnsafeConvertBytesToString(data[:i]), nil
-		} else if c == '\\' {
-			if next := i + 1; next < len(data) && (data[next] == '\\' || data[next] == '"') {
-				//copy(data[i:], data[next:])
-				data = append(data[:i], data[next:]...)
-			}
-		}
+	value, err := d.readString()
+	if err != nil {
+		return "", err
 	}
 
-	return "", errors.NewParseError(d.data[d.length-1], d.length-1)
+	return util.UnsafeConvertBytesToString(value), nil
 }
 
 func (d *Decoder) SkipString() error {
-	d.cursor++
-	data := d.data[d.cursor:]
+	end, _ := d.scanString()
+	if end < 0 {
+		return errors.NewParseError(d.data[d.length-1], d.length-1)
+	}
+
+	d.cursor = end + 1
+	return nil
+}
+
+// readString returns the value of the string at the cursor, the escape sequences are replaced in a new buffer,
+// so data is never modified and the cursor stays in sync with it.
+func (d *Decoder) readString() ([]byte, error) {
+	begin := d.cursor + 1
+
+	end, escaped := d.scanString()
+	if end < 0 {
+		return nil, errors.NewParseError(d.data[d.length-1], d.length-1)
+	}
+
+	if err := d.checkString(end - begin); err != nil {
+		return nil, err
+	}
+
+	d.cursor = end + 1
+
+	if !escaped {
+		return d.data[begin:end], nil
+	}
+
+	return unescape(d.data[begin:end], begin)
+}
+
+// scanString returns the index of the quote which closes the string at the cursor, or -1 if it is not closed,
+// and whether the string has escape sequences.
+func (d *Decoder) scanString() (int, bool) {
+	escaped := false
+
+	for i := d.cursor + 1; i < d.length; i++ {
+		switch d.data[i] {
+		case '"':
+			return i, escaped
+
+		case '\\':
+			escaped = true
+			i++
+		}
+	}
+
+	return -1, escaped
+}
+
+// unescape returns data with the escape sequences replaced, offset is the position of data in the input for errors.
+// data is returned without copy if it has no escape sequence.
+func unescape(data []byte, offset int) ([]byte, error) {
+	i := bytes.IndexByte(data, '\\')
+	if i < 0 {
+		return data, nil
+	}
+
+	buf := make([]byte, i, len(data))
+	copy(buf, data[:i])
+
+	for i < len(data) {
+		if data[i] != '\\' {
+			buf = append(buf, data[i])
+			i++
+			continue
+		}
+
+		if i+1 >= len(data) {
+			return nil, errors.NewParseError(data[i], offset+i)
+		}
+
+		switch c := data[i+1]; c {
+		case '"', '\\', '/':
+			buf = append(buf, c)
+
+		case 'b':
+			buf = append(buf, '\b')
 
-	for i, c := range data {
-		if c == '"' {
-			if prev := i - 1; prev >= 0 && data[prev] == '\\' {
-				continue
+		case 'f':
+			buf = append(buf, '\f')
+
+		case 'n':
+			buf = append(buf, '\n')
+
+		case 'r':
+			buf = append(buf, '\r')
+
+		case 't':
+			buf = append(buf, '\t')
+
+		case 'u':
+			r, ok := unhex(data[i+2:])
+			if !ok {
+				return nil, errors.NewParseError(c, offset+i+1)
 			}
 
-			d.cursor = d.cursor + i + 1
-			return nil
+			i += 6
+
+			if utf16.IsSurrogate(r) {
+				r2, ok := rune(0), false
+				if i+1 < len(data) && data[i] == '\\' && data[i+1] == 'u' {
+					r2, ok = unhex(data[i+2:])
+				}
+
+				if r = utf16.DecodeRune(r, r2); ok && r != unicode.ReplacementChar {
+					i += 6
+				}
+			}
+
+			buf = utf8.AppendRune(buf, r)
+			continue
+
+		default:
+			return nil, errors.NewParseError(c, offset+i+1)
+		}
+
+		i += 2
+	}
+
+	return buf, nil
+}
+
+// unhex returns the rune of the 4 hex digits at the beginning of data.
+func unhex(data []byte) (rune, bool) {
+	if len(data) < 4 {
+		return 0, false
+	}
+
+	var r rune
+	for _, c := range data[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			c -= '0'
+
+		case c >= 'a' && c <= 'f':
+			c = c - 'a' + 10
+
+		case c >= 'A' && c <= 'F':
+			c = c - 'A' + 10
+
+		default:
+			return 0, false
 		}
+
+		r = r<<4 | rune(c)
 	}
 
-	return errors.NewParseError(d.data[d.length-1], d.length-1)
+	return r, true
 }
@@ -78,6 +78,45 @@ func TestReadString(t *testing.T) {
 	assert.Equal(t, `"string with spaces and \"escape\"d \"quotes\" and escaped line returns \\n and escaped \\\\ escaped char"`, string(data), "data must be equal to the value expected")
 }
 
+func TestDecodeStringEscape(t *testing.T) {
+	data := []byte(`"a\"b\\c\/\n\u00e9\ud83d\ude00" `)
+	origin := string(data)
+
+	decoder := NewDecoder()
+	decoder.SetUnsafeData(data)
+	defer decoder.Release()
+
+	v, err := decoder.DecodeString()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "a\"b\\c/\n\u00e9\U0001F600", v, "v must be equal to the value expected")
+	assert.Nil(t, decoder.CheckEnd(), "Err must be nil")
+	assert.Equal(t, origin, string(data), "data must not be modified")
+
+	decoder.SetData([]byte(`"a\x"`))
+	_, err = decoder.DecodeString()
+	assert.Equal(t, errors.NewParseError('x', 3), err, "err must be equal to the value expected")
+}
+
+func TestNextKeyEscape(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(`{"a\"b\\": 1, "c": 2}`))
+	defer decoder.Release()
+
+	decoder.cursor++
+
+	key, err := decoder.NextKey()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "a\"b\\", key, "key must be equal to the value expected")
+
+	v, err := decoder.DecodeInt()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, 1, v, "v must be equal to the value expected")
+
+	key, err = decoder.NextKey()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "c", key, "key must be equal to the value expected")
+}
+
 func BenchmarkDecodeString(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		decoder := NewDecoder()
@@ -0,0 +1,92 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoderReset(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	data := []byte(`"gojson"`)
+	decoder.Reset(data)
+
+	s, err := decoder.DecodeString()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "gojson", s, "s must be equal to the value expected")
+
+	// data is copied
+	data[1] = 'G'
+	assert.Equal(t, "gojson", s, "s must be equal to the value expected")
+
+	decoder.Reset([]byte(`12345678901234567890`), WithNumber())
+	v, err := decoder.DecodeValue()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, Number("12345678901234567890"), v, "v must be equal to the value expected")
+
+	// options are not kept by later calls of Reset
+	decoder.Reset([]byte(`1`))
+	v, err = decoder.DecodeValue()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, float64(1), v, "v must be equal to the value expected")
+}
+
+func TestDecoderResetUnsafe(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	data := []byte(`"gojson"`)
+	decoder.Reset(data, WithUnsafe())
+
+	s, err := decoder.DecodeString()
+	assert.Nil(t, err, "Err must be nil")
+
+	// data is aliased
+	data[1] = 'G'
+	assert.Equal(t, "Gojson", s, "s must be equal to the value expected")
+}
+
+func TestDecoderResetAllocs(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	data := []byte(`{"name":"gojson","tags":[1,2,3]}`)
+	decoder.Reset(data)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		decoder.Reset(data)
+		if err := decoder.SkipValue(); err != nil {
+			t.Fatal(err)
+		}
+	})
+	assert.Equal(t, float64(0), allocs, "allocs must be zero")
+}
+
+func TestDecoderCheckEnd(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	decoder.Reset([]byte("{\"a\":1} \n\t"))
+	assert.Nil(t, decoder.SkipValue(), "Err must be nil")
+	assert.Nil(t, decoder.CheckEnd(), "Err must be nil")
+
+	decoder.Reset([]byte(`{"a":1}garbage`))
+	assert.Nil(t, decoder.SkipValue(), "Err must be nil")
+	assert.NotNil(t, decoder.CheckEnd(), "Err must not be nil")
+}
+
+func TestDecoderMore(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	decoder.Reset([]byte("{\"a\":1}\n{\"a\":2}\n"))
+
+	var offsets []int
+	for decoder.More() {
+		assert.Nil(t, decoder.SkipValue(), "Err must be nil")
+		offsets = append(offsets, decoder.InputOffset())
+	}
+	assert.Equal(t, []int{7, 15}, offsets, "offsets must be equal to the value expected")
+}
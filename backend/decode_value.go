@@ -45,7 +45,7 @@ func (d *Decoder) DecodeValue() (interface{}, error) {
 			return d.DecodeString()
 
 		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-			return d.DecodeFloat64()
+			return d.decodeNumberValue()
 
 		case 'f', 't':
 			return d.DecodeBool()
@@ -0,0 +1,70 @@
+package backend
+
+import "github.com/go-fish/gojson/errors"
+
+// DuplicateKeys is the policy of decoding objects with duplicate keys.
+type DuplicateKeys uint8
+
+const (
+	// AllowDuplicateKeys lets the last duplicate key win, which is the behavior of encoding/json.
+	AllowDuplicateKeys DuplicateKeys = iota
+	// FirstDuplicateKeyWins keeps the value of the first key and skips the values of later duplicates.
+	FirstDuplicateKeyWins
+	// RejectDuplicateKeys returns a DuplicateKeyError for duplicate keys.
+	RejectDuplicateKeys
+)
+
+// DefaultDuplicateKeys is the policy of decoders returned by NewDecoder, including the decoders of generated code,
+// it should only be set on initialization.
+var DefaultDuplicateKeys = AllowDuplicateKeys
+
+// SetDuplicateKeys sets the policy of duplicate keys of the decoder.
+func (d *Decoder) SetDuplicateKeys(policy DuplicateKeys) {
+	d.duplicateKeys = policy
+}
+
+// WithDuplicateKeys sets the policy of duplicate keys of the decoder in Reset.
+func WithDuplicateKeys(policy DuplicateKeys) DecoderOption {
+	return func(d *Decoder) { d.duplicateKeys = policy }
+}
+
+// Seen marks bit of the key decoded in seen, which is the bitmask of the known keys of an object in generated code,
+// and reports whether the value of key is a duplicate which has been skipped.
+func (d *Decoder) Seen(seen *uint64, bit uint, key string) (bool, error) {
+	if d.duplicateKeys == AllowDuplicateKeys {
+		return false, nil
+	}
+
+	if *seen&(1<<bit) == 0 {
+		*seen |= 1 << bit
+		return false, nil
+	}
+
+	return d.duplicate(key)
+}
+
+// SeenKey is Seen for the keys of maps, seen is allocated only if duplicate keys are not allowed.
+func (d *Decoder) SeenKey(seen *map[string]struct{}, key string) (bool, error) {
+	if d.duplicateKeys == AllowDuplicateKeys {
+		return false, nil
+	}
+
+	if *seen == nil {
+		*seen = make(map[string]struct{})
+	}
+
+	if _, ok := (*seen)[key]; !ok {
+		(*seen)[key] = struct{}{}
+		return false, nil
+	}
+
+	return d.duplicate(key)
+}
+
+func (d *Decoder) duplicate(key string) (bool, error) {
+	if d.duplicateKeys == RejectDuplicateKeys {
+		return false, errors.NewDuplicateKeyError(key, d.cursor)
+	}
+
+	return true, d.SkipValue()
+}
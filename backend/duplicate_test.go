@@ -0,0 +1,53 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuplicateKeys(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	data := []byte(`{"a":1,"b":{"c":2},"a":3}`)
+
+	decoder.Reset(data)
+	v, err := decoder.DecodeObject()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, float64(3), v["a"], "v must be equal to the value expected")
+
+	decoder.Reset(data, WithDuplicateKeys(FirstDuplicateKeyWins))
+	v, err = decoder.DecodeObject()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, map[string]interface{}{"a": float64(1), "b": map[string]interface{}{"c": float64(2)}}, v, "v must be equal to the value expected")
+
+	decoder.Reset(data, WithDuplicateKeys(RejectDuplicateKeys))
+	_, err = decoder.DecodeObject()
+	assert.IsType(t, &errors.DuplicateKeyError{}, err, "Err must be DuplicateKeyError")
+}
+
+func TestSeen(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	decoder.Reset([]byte(`1`), WithDuplicateKeys(FirstDuplicateKeyWins))
+
+	var seen uint64
+	dup, err := decoder.Seen(&seen, 3, "a")
+	assert.Nil(t, err, "Err must be nil")
+	assert.False(t, dup, "dup must be false")
+
+	dup, err = decoder.Seen(&seen, 3, "a")
+	assert.Nil(t, err, "Err must be nil")
+	assert.True(t, dup, "dup must be true")
+	assert.Equal(t, 1, decoder.Cursor(), "value must be skipped")
+
+	var keys map[string]struct{}
+	decoder.Reset([]byte(`1`), WithDuplicateKeys(RejectDuplicateKeys))
+	_, err = decoder.SeenKey(&keys, "a")
+	assert.Nil(t, err, "Err must be nil")
+	_, err = decoder.SeenKey(&keys, "a")
+	assert.IsType(t, &errors.DuplicateKeyError{}, err, "Err must be DuplicateKeyError")
+}
@@ -0,0 +1,135 @@
+package backend
+
+// ArrayEach calls fn for each element of the array at cursor, with the decoder positioned at the element.
+// fn may decode the element, or leave the cursor untouched to skip it, the array is walked in place without allocation.
+// null is walked as an empty array, the error returned by fn stops the walk and is returned.
+func (d *Decoder) ArrayEach(fn func(dec *Decoder, index int) error) error {
+	if c := d.NextChar(); c == 'n' {
+		return d.AssetNull()
+	} else if c != '[' {
+		return d.parseError()
+	}
+
+	if err := d.Enter(); err != nil {
+		return err
+	}
+
+	d.cursor++
+
+	if d.skipSpace() && d.data[d.cursor] == ']' {
+		d.cursor++
+		d.Leave()
+		return nil
+	}
+
+	for index := 0; ; index++ {
+		if err := d.CheckElements(index + 1); err != nil {
+			return err
+		}
+
+		if err := d.each(func(dec *Decoder) error { return fn(dec, index) }); err != nil {
+			return err
+		}
+
+		if !d.skipSpace() {
+			return d.parseError()
+		}
+
+		switch d.data[d.cursor] {
+		case ',':
+			d.cursor++
+
+		case ']':
+			d.cursor++
+			d.Leave()
+			return nil
+
+		default:
+			return d.parseError()
+		}
+	}
+}
+
+// ObjectEach calls fn for each member of the object at cursor, with the decoder positioned at the value.
+// key is the raw bytes of the key without quotes, escape sequences are kept, it aliases the data of the decoder.
+// fn may decode the value, or leave the cursor untouched to skip it, the object is walked in place without allocation.
+// null is walked as an empty object, the error returned by fn stops the walk and is returned.
+func (d *Decoder) ObjectEach(fn func(key []byte, dec *Decoder) error) error {
+	if c := d.NextChar(); c == 'n' {
+		return d.AssetNull()
+	} else if c != '{' {
+		return d.parseError()
+	}
+
+	if err := d.Enter(); err != nil {
+		return err
+	}
+
+	d.cursor++
+
+	if d.skipSpace() && d.data[d.cursor] == '}' {
+		d.cursor++
+		d.Leave()
+		return nil
+	}
+
+	for n := 1; ; n++ {
+		if err := d.CheckElements(n); err != nil {
+			return err
+		}
+
+		if !d.skipSpace() || d.data[d.cursor] != '"' {
+			return d.parseError()
+		}
+
+		key, err := d.ReadString()
+		if err != nil {
+			return err
+		}
+
+		if !d.skipSpace() || d.data[d.cursor] != ':' {
+			return d.parseError()
+		}
+
+		d.cursor++
+
+		if err := d.each(func(dec *Decoder) error { return fn(key[1:len(key)-1], dec) }); err != nil {
+			return err
+		}
+
+		if !d.skipSpace() {
+			return d.parseError()
+		}
+
+		switch d.data[d.cursor] {
+		case ',':
+			d.cursor++
+
+		case '}':
+			d.cursor++
+			d.Leave()
+			return nil
+
+		default:
+			return d.parseError()
+		}
+	}
+}
+
+// each calls fn with the decoder positioned at the next value, the value is skipped if fn does not move the cursor.
+func (d *Decoder) each(fn func(dec *Decoder) error) error {
+	if !d.skipSpace() {
+		return d.parseError()
+	}
+
+	cursor := d.cursor
+	if err := fn(d); err != nil {
+		return err
+	}
+
+	if d.cursor == cursor {
+		return d.SkipValue()
+	}
+
+	return nil
+}
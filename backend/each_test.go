@@ -0,0 +1,105 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoderArrayEach(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	decoder.Reset([]byte(`[ 1, {"skip": [1, 2]}, 3 ]`))
+
+	var sum int64
+	var indexes []int
+	err := decoder.ArrayEach(func(dec *Decoder, index int) error {
+		indexes = append(indexes, index)
+		if index == 1 {
+			return nil
+		}
+
+		v, err := dec.DecodeInt64()
+		sum += v
+		return err
+	})
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, int64(4), sum, "sum must be equal to the value expected")
+	assert.Equal(t, []int{0, 1, 2}, indexes, "indexes must be equal to the value expected")
+	assert.Nil(t, decoder.CheckEnd(), "Err must be nil")
+
+	for _, data := range []string{`[]`, `null`} {
+		decoder.Reset([]byte(data))
+		err := decoder.ArrayEach(func(dec *Decoder, index int) error {
+			t.Fatal("fn must not be called")
+			return nil
+		})
+		assert.Nil(t, err, "Err must be nil")
+	}
+
+	for _, data := range []string{`[1 2]`, `[1,]`, `{}`, `[1`} {
+		decoder.Reset([]byte(data))
+		err := decoder.ArrayEach(func(dec *Decoder, index int) error { return nil })
+		assert.NotNil(t, err, "Err must not be nil for %s", data)
+	}
+}
+
+func TestDecoderObjectEach(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	decoder.Reset([]byte(`{"name": "gojson", "tags": ["a", "b"], "stars": 10}`))
+
+	var keys []string
+	var name string
+	err := decoder.ObjectEach(func(key []byte, dec *Decoder) error {
+		keys = append(keys, string(key))
+		if string(key) != "name" {
+			return nil
+		}
+
+		var err error
+		name, err = dec.DecodeString()
+		return err
+	})
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "gojson", name, "name must be equal to the value expected")
+	assert.Equal(t, []string{"name", "tags", "stars"}, keys, "keys must be equal to the value expected")
+
+	for _, data := range []string{`{"a" 1}`, `{"a":1,}`, `{1:1}`, `[]`, `{"a":1`} {
+		decoder.Reset([]byte(data))
+		err := decoder.ObjectEach(func(key []byte, dec *Decoder) error { return nil })
+		assert.NotNil(t, err, "Err must not be nil for %s", data)
+	}
+}
+
+func TestDecoderEachAllocs(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	data := []byte(`{"items": [{"id": 1}, {"id": 2}, {"id": 3}], "count": 3}`)
+	decoder.Reset(data)
+
+	var sum int64
+	allocs := testing.AllocsPerRun(100, func() {
+		decoder.Reset(data)
+		err := decoder.ObjectEach(func(key []byte, dec *Decoder) error {
+			if string(key) != "items" {
+				return nil
+			}
+
+			return dec.ArrayEach(func(dec *Decoder, index int) error {
+				return dec.ObjectEach(func(key []byte, dec *Decoder) error {
+					v, err := dec.DecodeInt64()
+					sum += v
+					return err
+				})
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	assert.Equal(t, float64(0), allocs, "allocs must be zero")
+}
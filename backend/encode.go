@@ -1,24 +1,65 @@
 package backend
 
-import "sync"
+import (
+	"sync"
+	"sync/atomic"
+)
 
 type Encoder struct {
 	data []byte
 	err  error
+
+	// owned is set if data is provided by caller, which is never put back to the pool.
+	owned bool
 }
 
-var encoderPool = &sync.Pool{New: func() interface{} { return &Encoder{data: make([]byte, 0, 1024)} }}
+// encoderPool holds encoders without buffers, which are used by NewEncoderBuffer.
+var encoderPool = &sync.Pool{New: func() interface{} { return new(Encoder) }}
 
+// NewEncoder returns an encoder with a pooled buffer, the buffer is sized by the size of
+// recently encoded data, so steady-state encoding reuses buffers without allocations.
 func NewEncoder() *Encoder {
+	class := sizeClass(int(atomic.LoadInt64(&encodedSize)))
+	if enc, ok := bufferPools[class].Get().(*Encoder); ok {
+		return enc
+	}
+
+	return &Encoder{data: make([]byte, 0, bufferSizes[class])}
+}
+
+// NewEncoderBuffer returns an encoder appending to dst, dst is owned by caller and is never pooled.
+func NewEncoderBuffer(dst []byte) *Encoder {
 	enc := encoderPool.Get().(*Encoder)
-	enc.data = make([]byte, 0, 1024)
+	enc.data = dst
+	enc.owned = true
 
 	return enc
 }
 
+// Release puts the encoder back to the pool, the data returned by Bytes must not be used after Release
+// unless the encoder is created by NewEncoderBuffer.
 func (e *Encoder) Release() {
-	e.reset()
-	encoderPool.Put(e)
+	if e.owned {
+		e.data = nil
+		e.owned = false
+		e.err = nil
+		encoderPool.Put(e)
+		return
+	}
+
+	atomic.StoreInt64(&encodedSize, int64(len(e.data)))
+
+	if debug {
+		for i := range e.data {
+			e.data[i] = poisonByte
+		}
+	}
+
+	// buffers grown too large are left to gc instead of pinning memory in the pool
+	if class := capClass(cap(e.data)); class >= 0 {
+		e.reset()
+		bufferPools[class].Put(e)
+	}
 }
 
 func (e *Encoder) reset() {
@@ -66,6 +107,12 @@ func (e *Encoder) getPrevByte() (byte, bool) {
 	return e.data[len(e.data)-1], true
 }
 
+// Bytes returns the encoded data, which is reused by other encoders after Release.
 func (e *Encoder) Bytes() []byte {
 	return e.data
 }
+
+// Len returns the length of the encoded data.
+func (e *Encoder) Len() int {
+	return len(e.data)
+}
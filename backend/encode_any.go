@@ -0,0 +1,53 @@
+package backend
+
+import "encoding/json"
+
+// EncodeAny encodes values whose types are unknown at generation time, eg: fields of type parameters,
+// generated code passes pointers to the values like DecodeAny, so methods of pointer receivers such as the
+// generated EncodeTo are found. values which are not supported by EncodeValue are encoded by encoding/json.
+func (e *Encoder) EncodeAny(value interface{}) error {
+	switch x := value.(type) {
+	case nil:
+		e.WriteNull()
+
+	case Marshaler, json.Marshaler, string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64,
+		float32, float64, Number:
+		return e.EncodeValue(x)
+
+	case *interface{}:
+		return e.EncodeAny(*x)
+
+	case *string:
+		e.EncodeString(*x)
+
+	case *bool:
+		e.EncodeBool(*x)
+
+	case *int:
+		e.EncodeInt(*x)
+
+	case *int64:
+		e.EncodeInt64(*x)
+
+	case *float64:
+		e.EncodeFloat64(*x)
+
+	case *Number:
+		return e.EncodeNumber(string(*x))
+
+	default:
+		data, err := json.Marshal(x)
+		if err != nil {
+			return err
+		}
+
+		e.WriteBytes(data)
+	}
+
+	return nil
+}
+
+func (e *Encoder) EncodeKeyAny(key string, value interface{}) error {
+	e.WriteKey(key)
+	return e.EncodeAny(value)
+}
@@ -5,7 +5,9 @@ import (
 )
 
 func (e *Encoder) EncodeBytes(value []byte) {
+	e.WriteByte('"')
 	e.WriteString(base64.StdEncoding.EncodeToString(value))
+	e.WriteByte('"')
 }
 
 func (e *Encoder) EncoderKeyBytes(key string, value []byte) {
@@ -0,0 +1,20 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeBytes(t *testing.T) {
+	encoder := NewEncoder()
+	defer encoder.Release()
+
+	encoder.WriteByte('{')
+	encoder.EncoderKeyBytes("a", []byte("gojson"))
+	encoder.EncoderKeyBytes("b", nil)
+	encoder.WriteByte('}')
+	encoder.EncodeBytes([]byte{0xff, 0xfe})
+
+	assert.Equal(t, `{"a":"Z29qc29u","b":""}"//4="`, string(encoder.Bytes()), "data must be equal to the value expected")
+}
@@ -0,0 +1,34 @@
+package backend
+
+// EncodeNumber writes the literal text of a json number, an empty value is written as 0.
+func (e *Encoder) EncodeNumber(value string) error {
+	if value == "" {
+		e.WriteByte('0')
+		return nil
+	}
+
+	v := &validator{data: []byte(value)}
+	if err := v.number(); err != nil {
+		return err
+	}
+
+	if v.cursor != len(v.data) {
+		return v.fail()
+	}
+
+	e.WriteString(value)
+	return nil
+}
+
+func (e *Encoder) EncodeKeyNumber(key string, value string) error {
+	c, ok := e.getPrevByte()
+	if ok && c != '[' && c != '{' {
+		e.WriteByte(',')
+	}
+
+	if key != "" {
+		e.EncodeString(key)
+		e.WriteByte(':')
+	}
+	return e.EncodeNumber(value)
+}
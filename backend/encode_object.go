@@ -1,5 +1,7 @@
 package backend
 
+import "bytes"
+
 func (e *Encoder) EncodeObject(obj map[string]interface{}) {
 	if len(obj) == 0 {
 		e.WriteNull()
@@ -11,3 +13,42 @@ func (e *Encoder) EncodeObject(obj map[string]interface{}) {
 	}
 	e.WriteByte('}')
 }
+
+// EncodeTagged writes the encoded object data with key:tag injected as its first field,
+// data which is not an object is written as it is.
+func (e *Encoder) EncodeTagged(key, tag string, data []byte) {
+	data = bytes.TrimLeft(data, " \t\r\n")
+	if len(data) == 0 || data[0] != '{' {
+		e.WriteBytes(data)
+		return
+	}
+
+	e.WriteByte('{')
+	e.EncodeKeyString(key, tag)
+
+	if rest := bytes.TrimLeft(data[1:], " \t\r\n"); len(rest) > 0 && rest[0] != '}' {
+		e.WriteByte(',')
+	}
+
+	e.WriteBytes(data[1:])
+}
+
+// BeginTagged writes key:tag as the first field of a tagged object, the object encoded next is merged into it
+// by EndTagged with the offset returned, so the object does not need to be encoded into a buffer first.
+func (e *Encoder) BeginTagged(key, tag string) int {
+	e.WriteByte('{')
+	e.EncodeKeyString(key, tag)
+
+	return len(e.data)
+}
+
+// EndTagged merges the object encoded since offset into the tagged object.
+func (e *Encoder) EndTagged(offset int) {
+	if len(e.data) == offset+2 {
+		// empty object
+		e.data = append(e.data[:offset], '}')
+		return
+	}
+
+	e.data[offset] = ','
+}
@@ -0,0 +1,20 @@
+package backend
+
+func (e *Encoder) EncodeRaw(value []byte) error {
+	if len(value) == 0 {
+		e.WriteNull()
+		return nil
+	}
+
+	if err := Validate(value); err != nil {
+		return err
+	}
+
+	e.WriteBytes(value)
+	return nil
+}
+
+func (e *Encoder) EncodeKeyRaw(key string, value []byte) error {
+	e.WriteKey(key)
+	return e.EncodeRaw(value)
+}
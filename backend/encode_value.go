@@ -49,6 +49,12 @@ func (e *Encoder) EncodeValue(value interface{}) error {
 	case float64:
 		e.EncodeFloat64(x)
 
+	case Number:
+		return e.EncodeNumber(string(x))
+
+	case Marshaler:
+		return x.EncodeTo(e)
+
 	case json.Marshaler:
 		data, err := x.MarshalJSON()
 		if err != nil {
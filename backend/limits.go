@@ -0,0 +1,78 @@
+package backend
+
+import "github.com/go-fish/gojson/errors"
+
+// Limits bounds the resources used to decode untrusted input, zero means no limit.
+type Limits struct {
+	// MaxDepth limits the nesting depth of objects and arrays.
+	MaxDepth int
+
+	// MaxBytes limits the size of the document.
+	MaxBytes int
+
+	// MaxStringLength limits the length of strings and keys before unescaping.
+	MaxStringLength int
+
+	// MaxElements limits the number of elements of an array and the number of members of an object.
+	MaxElements int
+}
+
+// DefaultLimits are the limits of decoders returned by NewDecoder, including the decoders of generated code,
+// it should only be set on initialization, eg: backend.DefaultLimits = backend.Limits{MaxDepth: 64}.
+var DefaultLimits Limits
+
+// SetLimits replaces the limits of the decoder, the size of the data already set is checked.
+func (d *Decoder) SetLimits(limits Limits) error {
+	d.limits = limits
+	return d.CheckSize()
+}
+
+// CheckSize checks the size of the data against MaxBytes.
+func (d *Decoder) CheckSize() error {
+	return d.CheckLength(d.length)
+}
+
+// CheckLength checks the size of a document against MaxBytes before it is set, so that oversized input is
+// rejected without being copied.
+func (d *Decoder) CheckLength(n int) error {
+	if d.limits.MaxBytes > 0 && n > d.limits.MaxBytes {
+		return errors.NewLimitExceededError("document size", d.limits.MaxBytes, 0)
+	}
+
+	return nil
+}
+
+// Enter increases the depth of the decoder when a nested value is decoded, it must be paired with Leave.
+func (d *Decoder) Enter() error {
+	d.depth++
+	return d.checkDepth(d.depth)
+}
+
+func (d *Decoder) Leave() {
+	d.depth--
+}
+
+// CheckElements checks the number of elements decoded into a container against MaxElements.
+func (d *Decoder) CheckElements(n int) error {
+	if d.limits.MaxElements > 0 && n > d.limits.MaxElements {
+		return errors.NewLimitExceededError("number of elements", d.limits.MaxElements, d.cursor)
+	}
+
+	return nil
+}
+
+func (d *Decoder) checkDepth(depth int) error {
+	if d.limits.MaxDepth > 0 && depth > d.limits.MaxDepth {
+		return errors.NewLimitExceededError("depth", d.limits.MaxDepth, d.cursor)
+	}
+
+	return nil
+}
+
+func (d *Decoder) checkString(n int) error {
+	if d.limits.MaxStringLength > 0 && n > d.limits.MaxStringLength {
+		return errors.NewLimitExceededError("string length", d.limits.MaxStringLength, d.cursor)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package backend
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-fish/gojson/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitsDepth(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	data := []byte(strings.Repeat("[", 10) + strings.Repeat("]", 10))
+
+	decoder.Reset(data, WithLimits(Limits{MaxDepth: 5}))
+	_, err := decoder.DecodeValue()
+	assert.IsType(t, &errors.LimitExceededError{}, err, "Err must be LimitExceededError")
+
+	decoder.Reset(data, WithLimits(Limits{MaxDepth: 5}))
+	err = decoder.SkipValue()
+	assert.IsType(t, &errors.LimitExceededError{}, err, "Err must be LimitExceededError")
+
+	decoder.Reset(data, WithLimits(Limits{MaxDepth: 10}))
+	_, err = decoder.DecodeValue()
+	assert.Nil(t, err, "Err must be nil")
+}
+
+func TestLimitsElements(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	decoder.Reset([]byte(`{"a":[1,2,3],"b":2}`), WithLimits(Limits{MaxElements: 2}))
+	_, err := decoder.DecodeValue()
+	assert.IsType(t, &errors.LimitExceededError{}, err, "Err must be LimitExceededError")
+
+	decoder.Reset([]byte(`{"a":[1,2],"b":2}`), WithLimits(Limits{MaxElements: 2}))
+	_, err = decoder.DecodeValue()
+	assert.Nil(t, err, "Err must be nil")
+}
+
+func TestLimitsString(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	decoder.Reset([]byte(`"gojson"`), WithLimits(Limits{MaxStringLength: 5}))
+	_, err := decoder.DecodeString()
+	assert.IsType(t, &errors.LimitExceededError{}, err, "Err must be LimitExceededError")
+
+	decoder.Reset([]byte(`{"gojson":1}`), WithLimits(Limits{MaxStringLength: 5}))
+	_, err = decoder.DecodeObject()
+	assert.IsType(t, &errors.LimitExceededError{}, err, "Err must be LimitExceededError")
+}
+
+func TestLimitsBytes(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	decoder.Reset([]byte(`"gojson"`))
+	err := decoder.SetLimits(Limits{MaxBytes: 5})
+	assert.IsType(t, &errors.LimitExceededError{}, err, "Err must be LimitExceededError")
+	assert.Equal(t, "invalid json, document size exceeds the limit 5 at pos 0", err.Error(), "Err must be equal to the value expected")
+}
@@ -0,0 +1,13 @@
+package backend
+
+// Marshaler is implemented by types with methods generated by gojson,
+// EncodeTo encodes the value into the encoder of caller instead of allocating its own buffer.
+type Marshaler interface {
+	EncodeTo(enc *Encoder) error
+}
+
+// Unmarshaler is implemented by types with methods generated by gojson,
+// DecodeFrom decodes the next value of the decoder of caller instead of reading it first.
+type Unmarshaler interface {
+	DecodeFrom(dec *Decoder) error
+}
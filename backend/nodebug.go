@@ -0,0 +1,5 @@
+//go:build !gojsondebug
+
+package backend
+
+const debug = false
@@ -0,0 +1,32 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// Number is the literal text of a json number, it keeps the precision of
+// values which do not fit in a float64.
+type Number string
+
+func (n Number) String() string {
+	return string(n)
+}
+
+func (n Number) Float64() (float64, error) {
+	return strconv.ParseFloat(string(n), 64)
+}
+
+func (n Number) Int64() (int64, error) {
+	return strconv.ParseInt(string(n), 10, 64)
+}
+
+func (n Number) BigInt() (*big.Int, error) {
+	v, ok := new(big.Int).SetString(string(n), 10)
+	if !ok {
+		return nil, fmt.Errorf("Invalid integer %q", string(n))
+	}
+
+	return v, nil
+}
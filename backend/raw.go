@@ -0,0 +1,211 @@
+package backend
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+
+	"github.com/go-fish/gojson/util"
+)
+
+// Member is a member of a raw json object.
+type Member struct {
+	Key   string
+	Value []byte
+}
+
+// TypeOf returns the type of the raw json value.
+func TypeOf(data []byte) ValueType {
+	dec := NewDecoder()
+	dec.Reset(data, WithUnsafe())
+	defer dec.Release()
+
+	return dec.ValueType()
+}
+
+// Members returns the members of the raw json object in order, the keys are unescaped and the values alias data.
+func Members(data []byte) ([]Member, error) {
+	dec := NewDecoder()
+	dec.Reset(data, WithUnsafe())
+	defer dec.Release()
+
+	var ms []Member
+	err := dec.ObjectEach(func(key []byte, dec *Decoder) error {
+		name, err := unquoteKey(key)
+		if err != nil {
+			return err
+		}
+
+		value, err := dec.ReadRaw()
+		if err != nil {
+			return err
+		}
+
+		ms = append(ms, Member{name, value})
+		return nil
+	})
+
+	return ms, err
+}
+
+// Elements returns the elements of the raw json array in order, they alias data.
+func Elements(data []byte) ([][]byte, error) {
+	dec := NewDecoder()
+	dec.Reset(data, WithUnsafe())
+	defer dec.Release()
+
+	var es [][]byte
+	err := dec.ArrayEach(func(dec *Decoder, index int) error {
+		value, err := dec.ReadRaw()
+		if err != nil {
+			return err
+		}
+
+		es = append(es, value)
+		return nil
+	})
+
+	return es, err
+}
+
+// ReadRaw returns the raw json value at the cursor, null included, and moves the cursor after it.
+// the value aliases the data of the decoder.
+func (d *Decoder) ReadRaw() ([]byte, error) {
+	begin := d.cursor
+	if err := d.SkipValue(); err != nil {
+		return nil, err
+	}
+
+	return d.data[begin:d.cursor], nil
+}
+
+func unquoteKey(key []byte) (string, error) {
+	raw := make([]byte, 0, len(key)+2)
+	raw = append(append(append(raw, '"'), key...), '"')
+
+	name, err := Unquote(raw)
+	return string(name), err
+}
+
+// Equal reports whether the raw json values a and b are equal, whitespace and the order of keys are ignored,
+// strings are compared unescaped and numbers by value.
+func Equal(a, b []byte) (bool, error) {
+	typ := TypeOf(a)
+	if typ != TypeOf(b) {
+		return false, nil
+	}
+
+	switch typ {
+	case ObjectType:
+		ma, err := Members(a)
+		if err != nil {
+			return false, err
+		}
+
+		mb, err := Members(b)
+		if err != nil || len(ma) != len(mb) {
+			return false, err
+		}
+
+		values := make(map[string][]byte, len(mb))
+		for _, m := range mb {
+			values[m.Key] = m.Value
+		}
+
+		for _, m := range ma {
+			value, ok := values[m.Key]
+			if !ok {
+				return false, nil
+			}
+
+			if eq, err := Equal(m.Value, value); err != nil || !eq {
+				return false, err
+			}
+		}
+
+		return true, nil
+
+	case ArrayType:
+		ea, err := Elements(a)
+		if err != nil {
+			return false, err
+		}
+
+		eb, err := Elements(b)
+		if err != nil || len(ea) != len(eb) {
+			return false, err
+		}
+
+		for i := range ea {
+			if eq, err := Equal(ea[i], eb[i]); err != nil || !eq {
+				return false, err
+			}
+		}
+
+		return true, nil
+
+	case StringType:
+		sa, err := Unquote(Trim(a))
+		if err != nil {
+			return false, err
+		}
+
+		sb, err := Unquote(Trim(b))
+		if err != nil {
+			return false, err
+		}
+
+		return string(sa) == string(sb), nil
+
+	case NumberType:
+		sa, sb := string(Trim(a)), string(Trim(b))
+
+		na, okA := new(big.Rat).SetString(sa)
+		nb, okB := new(big.Rat).SetString(sb)
+		if okA && okB {
+			return na.Cmp(nb) == 0, nil
+		}
+
+		// big.Rat rejects valid numbers with exponents too large to expand, eg: 1e99999999
+		return canonicalNumber(sa) == canonicalNumber(sb), nil
+
+	default:
+		return string(Trim(a)) == string(Trim(b)), nil
+	}
+}
+
+// canonicalNumber returns the json number s as its significant digits and exponent, eg: -1.50e2 => -15e1,
+// so that numbers of equal value have the same text.
+func canonicalNumber(s string) string {
+	sign := ""
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+
+	exp := new(big.Int)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp.SetString(strings.TrimPrefix(s[i+1:], "+"), 10)
+		s = s[:i]
+	}
+
+	digits := s
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		digits = s[:i] + s[i+1:]
+		exp.Sub(exp, big.NewInt(int64(len(s)-i-1)))
+	}
+
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		return "0"
+	}
+
+	trimmed := strings.TrimRight(digits, "0")
+	exp.Add(exp, big.NewInt(int64(len(digits)-len(trimmed))))
+
+	return sign + trimmed + "e" + exp.String()
+}
+
+// trim returns data without the leading and trailing whitespace.
+func Trim(data []byte) []byte {
+	return bytes.TrimFunc(data, func(r rune) bool { return r < 0x80 && util.IsSkip(byte(r)) })
+}
@@ -0,0 +1,41 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMembers(t *testing.T) {
+	members, err := Members([]byte(`{"a": 1, "b\"c": [1, 2], "d": {}}`))
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, []Member{{"a", []byte("1")}, {`b"c`, []byte("[1, 2]")}, {"d", []byte("{}")}}, members, "members must be equal to the value expected")
+
+	elements, err := Elements([]byte(`[1, "a", null]`))
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, [][]byte{[]byte("1"), []byte(`"a"`), []byte("null")}, elements, "elements must be equal to the value expected")
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{`{"a": 1, "b": [1.0, "x"]}`, `{"b":[1e0,"x"],"a":1}`, true},
+		{`{"a": 1}`, `{"a": 1, "b": 2}`, false},
+		{`[1, 2]`, `[2, 1]`, false},
+		{`100000000000000000001`, `100000000000000000000`, false},
+		{` null`, `null `, true},
+		{`true`, `1`, false},
+		{`1e99999999`, `10e99999998`, true},
+		{`1e99999999`, `1e99999998`, false},
+		{`-0.00150e-99999999`, `-15E-100000003`, true},
+		{`0.0e99999999`, `-0`, true},
+	}
+
+	for _, test := range tests {
+		eq, err := Equal([]byte(test.a), []byte(test.b))
+		assert.Nil(t, err, "Err must be nil")
+		assert.Equal(t, test.equal, eq, "%s and %s must be equal as expected", test.a, test.b)
+	}
+}
@@ -0,0 +1,238 @@
+package backend
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/go-fish/gojson/errors"
+)
+
+// ValueType is the type of a json value.
+type ValueType uint8
+
+const (
+	NotExist ValueType = iota
+	StringType
+	NumberType
+	ObjectType
+	ArrayType
+	BoolType
+	NullType
+)
+
+func (v ValueType) String() string {
+	switch v {
+	case StringType:
+		return "string"
+
+	case NumberType:
+		return "number"
+
+	case ObjectType:
+		return "object"
+
+	case ArrayType:
+		return "array"
+
+	case BoolType:
+		return "bool"
+
+	case NullType:
+		return "null"
+
+	default:
+		return "not exist"
+	}
+}
+
+// ValueType returns the type of the value at cursor, the cursor is moved past whitespace.
+func (d *Decoder) ValueType() ValueType {
+	if !d.skipSpace() {
+		return NotExist
+	}
+
+	switch d.data[d.cursor] {
+	case '"':
+		return StringType
+
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return NumberType
+
+	case '{':
+		return ObjectType
+
+	case '[':
+		return ArrayType
+
+	case 't', 'f':
+		return BoolType
+
+	case 'n':
+		return NullType
+
+	default:
+		return NotExist
+	}
+}
+
+// Seek moves the cursor to the value at path in the value at cursor, the values on the way are skipped without decoding.
+// an element of path is a key of an object, or an index of an array in brackets, eg: Seek("users", "[0]", "name").
+// keys are matched against the unescaped keys of data, eg: "a\u0062" matches ab, a leading backslash escapes
+// the element, it is removed and the rest is matched as a key, eg: \[0] matches the key [0].
+// a *errors.KeyPathNotFoundError is returned if there is no value at path.
+func (d *Decoder) Seek(path ...string) error {
+	for _, elem := range path {
+		if !d.skipSpace() {
+			return d.parseError()
+		}
+
+		var found bool
+		var err error
+
+		if index, ok := pathIndex(elem); ok {
+			found, err = d.seekIndex(index)
+		} else {
+			if len(elem) > 0 && elem[0] == '\\' {
+				elem = elem[1:]
+			}
+
+			found, err = d.seekKey(elem)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			// path is copied so that it does not escape when found
+			return errors.NewKeyPathNotFoundError(append([]string(nil), path...))
+		}
+	}
+
+	if !d.skipSpace() {
+		return d.parseError()
+	}
+
+	return nil
+}
+
+// pathIndex returns the index of an element of path in brackets, eg: [0].
+func pathIndex(elem string) (int, bool) {
+	if len(elem) < 3 || elem[0] != '[' || elem[len(elem)-1] != ']' {
+		return 0, false
+	}
+
+	index, err := strconv.Atoi(elem[1 : len(elem)-1])
+	if err != nil || index < 0 {
+		return 0, false
+	}
+
+	return index, true
+}
+
+func (d *Decoder) seekIndex(index int) (bool, error) {
+	if d.data[d.cursor] != '[' {
+		return false, nil
+	}
+
+	d.cursor++
+
+	for i := 0; ; i++ {
+		if !d.skipSpace() {
+			return false, d.parseError()
+		}
+
+		if d.data[d.cursor] == ']' {
+			return false, nil
+		}
+
+		if i == index {
+			return true, nil
+		}
+
+		if err := d.SkipValue(); err != nil {
+			return false, err
+		}
+
+		if !d.skipSpace() {
+			return false, d.parseError()
+		}
+
+		switch d.data[d.cursor] {
+		case ',':
+			d.cursor++
+
+		case ']':
+			return false, nil
+
+		default:
+			return false, d.parseError()
+		}
+	}
+}
+
+func (d *Decoder) seekKey(key string) (bool, error) {
+	if d.data[d.cursor] != '{' {
+		return false, nil
+	}
+
+	d.cursor++
+
+	for {
+		if !d.skipSpace() {
+			return false, d.parseError()
+		}
+
+		if d.data[d.cursor] == '}' {
+			return false, nil
+		}
+
+		if d.data[d.cursor] != '"' {
+			return false, d.parseError()
+		}
+
+		name, err := d.ReadString()
+		if err != nil {
+			return false, err
+		}
+
+		if !d.skipSpace() || d.data[d.cursor] != ':' {
+			return false, d.parseError()
+		}
+
+		d.cursor++
+
+		if matchKey(name, key) {
+			return true, nil
+		}
+
+		if err := d.SkipValue(); err != nil {
+			return false, err
+		}
+
+		if !d.skipSpace() {
+			return false, d.parseError()
+		}
+
+		switch d.data[d.cursor] {
+		case ',':
+			d.cursor++
+
+		case '}':
+			return false, nil
+
+		default:
+			return false, d.parseError()
+		}
+	}
+}
+
+// matchKey reports whether the raw json string name is key once unescaped.
+func matchKey(name []byte, key string) bool {
+	if bytes.IndexByte(name, '\\') < 0 {
+		return len(name) == len(key)+2 && string(name[1:len(name)-1]) == key
+	}
+
+	value, err := Unquote(name)
+	return err == nil && string(value) == key
+}
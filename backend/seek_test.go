@@ -0,0 +1,70 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoderSeek(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	data := []byte(`{"person": {"name": "gojson", "tags": ["a", {"b": true}], "[0]": 1, "a\u0062": null}, "count": 2}`)
+
+	tests := []struct {
+		path     []string
+		value    string
+		valueTyp ValueType
+	}{
+		{nil, string(data), ObjectType},
+		{[]string{"person", "name"}, `"gojson"`, StringType},
+		{[]string{"person", "tags", "[1]", "b"}, `true`, BoolType},
+		{[]string{"person", `\[0]`}, `1`, NumberType},
+		{[]string{"person", "ab"}, `null`, NullType},
+		{[]string{"count"}, `2`, NumberType},
+	}
+
+	for _, test := range tests {
+		decoder.Reset(data)
+
+		assert.Nil(t, decoder.Seek(test.path...), "Err must be nil")
+		assert.Equal(t, test.valueTyp, decoder.ValueType(), "type must be equal to the value expected")
+
+		begin := decoder.Cursor()
+		assert.Nil(t, decoder.SkipValue(), "Err must be nil")
+		assert.Equal(t, test.value, string(data[begin:decoder.Cursor()]), "value must be equal to the value expected")
+	}
+
+	for _, path := range [][]string{{"missing"}, {"person", "tags", "[2]"}, {"count", "a"}, {"person", "[0]"}} {
+		decoder.Reset(data)
+
+		err := decoder.Seek(path...)
+		assert.IsType(t, &errors.KeyPathNotFoundError{}, err, "Err must be a KeyPathNotFoundError")
+	}
+}
+
+func TestUnquote(t *testing.T) {
+	tests := map[string]string{
+		`"gojson"`:           "gojson",
+		`""`:                 "",
+		`"a\"b\\c\/d"`:       `a"b\c/d`,
+		`"\b\f\n\r\t"`:       "\b\f\n\r\t",
+		`"\u00e9t\u00E9"`:    "\u00e9t\u00e9",
+		`"\ud83d\ude00"`:     "\U0001F600",
+		`"\ud83dx"`:          "\uFFFDx",
+		`"caf\u00e9 \u4e2d"`: "caf\u00e9 \u4e2d",
+	}
+
+	for raw, expected := range tests {
+		value, err := Unquote([]byte(raw))
+		assert.Nil(t, err, "Err must be nil")
+		assert.Equal(t, expected, string(value), "value must be equal to the value expected")
+	}
+
+	for _, raw := range []string{``, `"`, `gojson`, `"\x"`, `"\u12"`, `"a\"`} {
+		_, err := Unquote([]byte(raw))
+		assert.NotNil(t, err, "Err must not be nil for %s", raw)
+	}
+}
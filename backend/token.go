@@ -0,0 +1,250 @@
+package backend
+
+import "io"
+
+// Delim is a json delimiter returned by Token, one of [ ] { }.
+type Delim byte
+
+func (d Delim) String() string {
+	return string(d)
+}
+
+// Token is a value returned by Token, one of these types:
+//
+//	Delim, for the four json delimiters [ ] { }
+//	string, for json strings, including object keys
+//	Number, for json numbers
+//	bool, for json booleans
+//	nil, for json null
+type Token interface{}
+
+type tokenState uint8
+
+const (
+	tokenTopValue tokenState = iota
+	tokenArrayStart
+	tokenArrayValue
+	tokenArrayComma
+	tokenObjectStart
+	tokenObjectKey
+	tokenObjectColon
+	tokenObjectValue
+	tokenObjectComma
+)
+
+// Token returns the next token of data, io.EOF is returned at the end of data.
+// Token checks that delimiters, commas and colons are well placed, but does not return commas and colons,
+// it can be mixed with Decode to decode the values of a huge array one by one, eg:
+//
+//	dec.Token() // [
+//	for dec.More() {
+//		var v Item
+//		if err := dec.Decode(&v); err != nil {
+//			return err
+//		}
+//	}
+//	dec.Token() // ]
+//
+// strings returned alias the data of the decoder, see Reset.
+func (d *Decoder) Token() (Token, error) {
+	for {
+		if !d.skipSpace() {
+			if d.tokenState == tokenTopValue && len(d.tokenStack) == 0 {
+				return nil, io.EOF
+			}
+
+			return nil, d.parseError()
+		}
+
+		switch c := d.data[d.cursor]; c {
+		case '[', '{':
+			if !d.tokenValueAllowed() {
+				return nil, d.parseError()
+			}
+
+			if err := d.Enter(); err != nil {
+				return nil, err
+			}
+
+			d.cursor++
+			d.tokenStack = append(d.tokenStack, d.tokenState)
+			if c == '[' {
+				d.tokenState = tokenArrayStart
+			} else {
+				d.tokenState = tokenObjectStart
+			}
+
+			return Delim(c), nil
+
+		case ']':
+			if d.tokenState != tokenArrayStart && d.tokenState != tokenArrayComma {
+				return nil, d.parseError()
+			}
+
+			d.cursor++
+			d.closeToken()
+			return Delim(c), nil
+
+		case '}':
+			if d.tokenState != tokenObjectStart && d.tokenState != tokenObjectComma {
+				return nil, d.parseError()
+			}
+
+			d.cursor++
+			d.closeToken()
+			return Delim(c), nil
+
+		case ',':
+			switch d.tokenState {
+			case tokenArrayComma:
+				d.tokenState = tokenArrayValue
+
+			case tokenObjectComma:
+				d.tokenState = tokenObjectKey
+
+			default:
+				return nil, d.parseError()
+			}
+
+			d.cursor++
+
+		case ':':
+			if d.tokenState != tokenObjectColon {
+				return nil, d.parseError()
+			}
+
+			d.tokenState = tokenObjectValue
+			d.cursor++
+
+		case '"':
+			if d.tokenState == tokenObjectStart || d.tokenState == tokenObjectKey {
+				key, err := d.DecodeString()
+				if err != nil {
+					return nil, err
+				}
+
+				d.tokenState = tokenObjectColon
+				return key, nil
+			}
+
+			return d.primitiveToken()
+
+		default:
+			return d.primitiveToken()
+		}
+	}
+}
+
+// Decode decodes the next value into v, which must be a pointer, see DecodeAny.
+// it is used with Token to decode values in the middle of data, io.EOF is returned at the end of data.
+func (d *Decoder) Decode(v interface{}) error {
+	if !d.skipSpace() {
+		if d.tokenState == tokenTopValue && len(d.tokenStack) == 0 {
+			return io.EOF
+		}
+
+		return d.parseError()
+	}
+
+	switch {
+	case d.tokenState == tokenArrayComma && d.data[d.cursor] == ',':
+		d.tokenState = tokenArrayValue
+		d.cursor++
+
+	case d.tokenState == tokenObjectColon && d.data[d.cursor] == ':':
+		d.tokenState = tokenObjectValue
+		d.cursor++
+	}
+
+	if !d.tokenValueAllowed() || !d.skipSpace() {
+		return d.parseError()
+	}
+
+	if err := d.DecodeAny(v); err != nil {
+		return err
+	}
+
+	d.endToken()
+	return nil
+}
+
+func (d *Decoder) primitiveToken() (Token, error) {
+	if !d.tokenValueAllowed() {
+		return nil, d.parseError()
+	}
+
+	var v Token
+	var err error
+
+	switch d.data[d.cursor] {
+	case '"':
+		v, err = d.DecodeString()
+
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		v, err = d.DecodeNumber()
+
+	case 't', 'f':
+		v, err = d.DecodeBool()
+
+	case 'n':
+		err = d.AssetNull()
+
+	default:
+		return nil, d.parseError()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.endToken()
+	return v, nil
+}
+
+func (d *Decoder) tokenValueAllowed() bool {
+	switch d.tokenState {
+	case tokenTopValue, tokenArrayStart, tokenArrayValue, tokenObjectValue:
+		return true
+	}
+
+	return false
+}
+
+// endToken moves the state past a value.
+func (d *Decoder) endToken() {
+	switch d.tokenState {
+	case tokenArrayStart, tokenArrayValue:
+		d.tokenState = tokenArrayComma
+
+	case tokenObjectValue:
+		d.tokenState = tokenObjectComma
+	}
+}
+
+// closeToken restores the state of the enclosing value when an array or object is closed.
+func (d *Decoder) closeToken() {
+	d.Leave()
+
+	d.tokenState = d.tokenStack[len(d.tokenStack)-1]
+	d.tokenStack = d.tokenStack[:len(d.tokenStack)-1]
+	d.endToken()
+}
+
+func (d *Decoder) resetToken() {
+	d.tokenState = tokenTopValue
+	d.tokenStack = d.tokenStack[:0]
+}
+
+// skipSpace moves the cursor to the next character which is not whitespace, false is returned at the end of data.
+func (d *Decoder) skipSpace() bool {
+	for ; d.cursor < d.length; d.cursor++ {
+		switch d.data[d.cursor] {
+		case ' ', '\n', '\t', '\r':
+
+		default:
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,92 @@
+package backend
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoderToken(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	decoder.Reset([]byte(` {"a": [1, "x", true, null], "b": {}} [] `))
+
+	expected := []Token{
+		Delim('{'), "a", Delim('['), Number("1"), "x", true, nil, Delim(']'), "b", Delim('{'), Delim('}'), Delim('}'),
+		Delim('['), Delim(']'),
+	}
+
+	var tokens []Token
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+
+		assert.Nil(t, err, "Err must be nil")
+		tokens = append(tokens, token)
+	}
+	assert.Equal(t, expected, tokens, "tokens must be equal to the value expected")
+}
+
+func TestDecoderTokenInvalid(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	for _, data := range []string{`[1 2]`, `[1,]`, `{"a" 1}`, `{"a":1,}`, `{1:1}`, `[}`, `]`, `{"a":1`} {
+		decoder.Reset([]byte(data))
+
+		var err error
+		for err == nil {
+			_, err = decoder.Token()
+		}
+		assert.NotEqual(t, io.EOF, err, "Err must not be io.EOF for %s", data)
+	}
+}
+
+func TestDecoderDecode(t *testing.T) {
+	decoder := NewDecoder()
+	defer decoder.Release()
+
+	decoder.Reset([]byte(`{"items": [{"a":1}, {"a":2}], "count": 2}`))
+
+	token, err := decoder.Token()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, Delim('{'), token, "token must be equal to the value expected")
+
+	token, err = decoder.Token()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "items", token, "token must be equal to the value expected")
+
+	token, err = decoder.Token()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, Delim('['), token, "token must be equal to the value expected")
+
+	var items []interface{}
+	for decoder.More() {
+		var item interface{}
+		assert.Nil(t, decoder.Decode(&item), "Err must be nil")
+		items = append(items, item)
+	}
+	assert.Equal(t, []interface{}{map[string]interface{}{"a": float64(1)}, map[string]interface{}{"a": float64(2)}}, items, "items must be equal to the value expected")
+
+	token, err = decoder.Token()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, Delim(']'), token, "token must be equal to the value expected")
+
+	token, err = decoder.Token()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "count", token, "token must be equal to the value expected")
+
+	var count int
+	assert.Nil(t, decoder.Decode(&count), "Err must be nil")
+	assert.Equal(t, 2, count, "count must be equal to the value expected")
+
+	token, err = decoder.Token()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, Delim('}'), token, "token must be equal to the value expected")
+
+	assert.Equal(t, io.EOF, decoder.Decode(&count), "Err must be io.EOF")
+}
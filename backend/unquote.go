@@ -0,0 +1,19 @@
+package backend
+
+import (
+	"github.com/go-fish/gojson/errors"
+)
+
+// Unquote returns the value of the raw json string, quotes included, with the escape sequences replaced.
+// the content of raw is returned without copy if it has no escape sequence.
+func Unquote(raw []byte) ([]byte, error) {
+	if len(raw) < 2 || raw[0] != '"' || raw[len(raw)-1] != '"' {
+		if len(raw) == 0 {
+			return nil, errors.NewParseError(0, 0)
+		}
+
+		return nil, errors.NewParseError(raw[0], 0)
+	}
+
+	return unescape(raw[1:len(raw)-1], 1)
+}
@@ -0,0 +1,265 @@
+package backend
+
+import (
+	"github.com/go-fish/gojson/errors"
+	"github.com/go-fish/gojson/util"
+)
+
+type validator struct {
+	data   []byte
+	cursor int
+}
+
+// Validate checks that data holds exactly one well-formed json value.
+func Validate(data []byte) error {
+	v := &validator{data: data}
+
+	v.skip()
+	if err := v.value(); err != nil {
+		return err
+	}
+
+	v.skip()
+	if v.cursor < len(v.data) {
+		return v.fail()
+	}
+
+	return nil
+}
+
+func (v *validator) skip() {
+	for v.cursor < len(v.data) && util.IsSkip(v.data[v.cursor]) {
+		v.cursor++
+	}
+}
+
+func (v *validator) fail() error {
+	if len(v.data) == 0 {
+		return errors.NewParseError(0, 0)
+	}
+
+	if v.cursor >= len(v.data) {
+		return errors.NewParseError(v.data[len(v.data)-1], len(v.data)-1)
+	}
+
+	return errors.NewParseError(v.data[v.cursor], v.cursor)
+}
+
+func (v *validator) literal(lit string) error {
+	if len(v.data)-v.cursor < len(lit) || string(v.data[v.cursor:v.cursor+len(lit)]) != lit {
+		return v.fail()
+	}
+
+	v.cursor += len(lit)
+	return nil
+}
+
+func (v *validator) value() error {
+	if v.cursor >= len(v.data) {
+		return v.fail()
+	}
+
+	switch v.data[v.cursor] {
+	case '{':
+		return v.object()
+
+	case '[':
+		return v.array()
+
+	case '"':
+		return v.string()
+
+	case 't':
+		return v.literal("true")
+
+	case 'f':
+		return v.literal("false")
+
+	case 'n':
+		return v.literal("null")
+
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return v.number()
+
+	default:
+		return v.fail()
+	}
+}
+
+func (v *validator) object() error {
+	v.cursor++
+	v.skip()
+
+	if v.cursor < len(v.data) && v.data[v.cursor] == '}' {
+		v.cursor++
+		return nil
+	}
+
+	for {
+		if v.cursor >= len(v.data) || v.data[v.cursor] != '"' {
+			return v.fail()
+		}
+
+		if err := v.string(); err != nil {
+			return err
+		}
+
+		v.skip()
+		if v.cursor >= len(v.data) || v.data[v.cursor] != ':' {
+			return v.fail()
+		}
+
+		v.cursor++
+		v.skip()
+
+		if err := v.value(); err != nil {
+			return err
+		}
+
+		v.skip()
+		if v.cursor >= len(v.data) {
+			return v.fail()
+		}
+
+		switch v.data[v.cursor] {
+		case ',':
+			v.cursor++
+			v.skip()
+
+		case '}':
+			v.cursor++
+			return nil
+
+		default:
+			return v.fail()
+		}
+	}
+}
+
+func (v *validator) array() error {
+	v.cursor++
+	v.skip()
+
+	if v.cursor < len(v.data) && v.data[v.cursor] == ']' {
+		v.cursor++
+		return nil
+	}
+
+	for {
+		if err := v.value(); err != nil {
+			return err
+		}
+
+		v.skip()
+		if v.cursor >= len(v.data) {
+			return v.fail()
+		}
+
+		switch v.data[v.cursor] {
+		case ',':
+			v.cursor++
+			v.skip()
+
+		case ']':
+			v.cursor++
+			return nil
+
+		default:
+			return v.fail()
+		}
+	}
+}
+
+func (v *validator) string() error {
+	v.cursor++
+
+	for v.cursor < len(v.data) {
+		switch c := v.data[v.cursor]; {
+		case c == '"':
+			v.cursor++
+			return nil
+
+		case c == '\\':
+			v.cursor++
+			if v.cursor >= len(v.data) {
+				return v.fail()
+			}
+
+			switch v.data[v.cursor] {
+			case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+				v.cursor++
+
+			case 'u':
+				v.cursor++
+				for i := 0; i < 4; i++ {
+					if v.cursor >= len(v.data) || !isHex(v.data[v.cursor]) {
+						return v.fail()
+					}
+
+					v.cursor++
+				}
+
+			default:
+				return v.fail()
+			}
+
+		case c < 0x20:
+			return v.fail()
+
+		default:
+			v.cursor++
+		}
+	}
+
+	return v.fail()
+}
+
+func (v *validator) number() error {
+	if v.data[v.cursor] == '-' {
+		v.cursor++
+	}
+
+	if v.cursor >= len(v.data) || !util.IsNumber(v.data[v.cursor]) {
+		return v.fail()
+	}
+
+	if v.data[v.cursor] == '0' {
+		v.cursor++
+	} else {
+		v.digits()
+	}
+
+	if v.cursor < len(v.data) && v.data[v.cursor] == '.' {
+		v.cursor++
+		if v.cursor >= len(v.data) || !util.IsNumber(v.data[v.cursor]) {
+			return v.fail()
+		}
+
+		v.digits()
+	}
+
+	if v.cursor < len(v.data) && (v.data[v.cursor] == 'e' || v.data[v.cursor] == 'E') {
+		v.cursor++
+		if v.cursor < len(v.data) && (v.data[v.cursor] == '+' || v.data[v.cursor] == '-') {
+			v.cursor++
+		}
+
+		if v.cursor >= len(v.data) || !util.IsNumber(v.data[v.cursor]) {
+			return v.fail()
+		}
+
+		v.digits()
+	}
+
+	return nil
+}
+
+func (v *validator) digits() {
+	for v.cursor < len(v.data) && util.IsNumber(v.data[v.cursor]) {
+		v.cursor++
+	}
+}
+
+func isHex(c byte) bool {
+	return util.IsNumber(c) || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
@@ -0,0 +1,67 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	valid := []string{
+		`null`,
+		`  true `,
+		`-12.5e+3`,
+		`"esc\"aped é"`,
+		`[]`,
+		`{}`,
+		`{"a": [1, {"b": null}], "c": "d"}`,
+	}
+
+	for _, data := range valid {
+		assert.Nil(t, Validate([]byte(data)), "Err must be nil for %s", data)
+	}
+}
+
+func TestValidateInvalid(t *testing.T) {
+	invalid := []string{
+		``,
+		`nul`,
+		`01`,
+		`1.`,
+		`"unterminated`,
+		`[1,]`,
+		`{"a" 1}`,
+		`{"a":1,}`,
+		`{"a":1} {}`,
+	}
+
+	for _, data := range invalid {
+		assert.NotNil(t, Validate([]byte(data)), "Err must not be nil for %s", data)
+	}
+}
+
+func TestValidatePosition(t *testing.T) {
+	err := Validate([]byte(`[1, x]`))
+	assert.Equal(t, errors.NewParseError('x', 4), err, "err must be equal to the value expected")
+}
+
+func TestEncodeRaw(t *testing.T) {
+	encoder := NewEncoder()
+	defer encoder.Release()
+
+	encoder.WriteByte('{')
+	assert.Nil(t, encoder.EncodeKeyRaw("a", []byte(`{"b":[1,2]}`)), "Err must be nil")
+	assert.Nil(t, encoder.EncodeKeyRaw("c", nil), "Err must be nil")
+	assert.NotNil(t, encoder.EncodeKeyRaw("d", []byte(`{`)), "Err must not be nil")
+}
+
+func TestReadValueNegativeNumber(t *testing.T) {
+	decoder := NewDecoder()
+	decoder.SetData([]byte(`-12.5,`))
+	defer decoder.Release()
+
+	data, err := decoder.ReadValue()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "-12.5", string(data), "data must be equal to the value expected")
+}
@@ -12,7 +12,32 @@ import (
 
 func (a *Agent) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := a.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (a *Agent) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := a.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (a *Agent) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("hostname", a.Hostname)
 
@@ -25,15 +50,36 @@ func (a *Agent) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyString("ephemeral_id", a.EphemeralID)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (a *Agent) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := a.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (a *Agent) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -44,55 +90,84 @@ func (a *Agent) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj975b114a623af7b0 := 1; obj975b114a623af7b0 > 0; {
-				keyacd46aef3059f22d, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen163f5f0f9a621d72 [1]uint64
+			for obj52fdfc072182654f := 1; obj52fdfc072182654f > 0; {
+				key9566c74d10037c4d, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch keyacd46aef3059f22d {
+				switch key9566c74d10037c4d {
 				case "hostname":
-					value955304564ec12056, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen163f5f0f9a621d72[0], 0, "hostname"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value7bbb0407d1e2c649, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					a.Hostname = value955304564ec12056
+					a.Hostname = value7bbb0407d1e2c649
 
 				case "id":
-					value0aa01f7cd40adf21, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen163f5f0f9a621d72[0], 1, "id"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value81855ad8681d0d86, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					a.ID = value0aa01f7cd40adf21
+					a.ID = value81855ad8681d0d86
 
 				case "version":
-					value6684f3d921884f6f, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen163f5f0f9a621d72[0], 2, "version"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valued1e91e00167939cb, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					a.Version = value6684f3d921884f6f
+					a.Version = valued1e91e00167939cb
 
 				case "type":
-					value9f87960c4ddd8c54, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen163f5f0f9a621d72[0], 3, "type"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value6694d2c422acd208, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					a.Type = value9f87960c4ddd8c54
+					a.Type = value6694d2c422acd208
 
 				case "ephemeral_id":
-					value93f287f75316b697, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen163f5f0f9a621d72[0], 4, "ephemeral_id"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuea0072939487f6999, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					a.EphemeralID = value93f287f75316b697
+					a.EphemeralID = valuea0072939487f6999
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -100,32 +175,205 @@ func (a *Agent) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj975b114a623af7b0--
+					obj52fdfc072182654f--
 				}
 			}
 		}
 	}
+	dec.Leave()
+
+	return nil
+}
+
+func (b *Body) MarshalJSON() ([]byte, error) {
+	enc := backend.NewEncoder()
+	if err := b.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (b *Body) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := b.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
+
+func (b *Body) EncodeTo(enc *backend.Encoder) error {
+	enc.WriteByte('{')
+	enc.EncodeKeyString("content", b.Content)
+
+	enc.EncodeKeyInt("bytes", b.Bytes)
+
+	enc.WriteByte('}')
+
+	return nil
+}
+
+func (b *Body) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	dec := backend.NewDecoder()
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := b.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
 	dec.Release()
 
+	return err
+}
+
+func (b *Body) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
+
+	if char := dec.NextChar(); char == 'n' {
+		if err := dec.AssetNull(); err != nil {
+			return err
+		}
+
+	} else if char != '{' {
+		return errors.NewParseError(dec.Char(), dec.Cursor())
+	} else {
+		dec.Next()
+		if !dec.IsObjectClose() {
+			var seen87f3c67cf22746e9 [1]uint64
+			for objeb9d18a44784045d := 1; objeb9d18a44784045d > 0; {
+				key95af5a25367951ba, err := dec.NextKey()
+				if err != nil {
+					return err
+				}
+
+				switch key95af5a25367951ba {
+				case "content":
+					if dup, err := dec.Seen(&seen87f3c67cf22746e9[0], 0, "content"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuea2ff6cd471c483f1, err := dec.DecodeString()
+					if err != nil {
+						return err
+					}
+
+					b.Content = valuea2ff6cd471c483f1
+
+				case "bytes":
+					if dup, err := dec.Seen(&seen87f3c67cf22746e9[0], 1, "bytes"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value5fb90badb37c5821, err := dec.DecodeInt()
+					if err != nil {
+						return err
+					}
+
+					b.Bytes = value5fb90badb37c5821
+
+				default:
+					if err := dec.SkipValue(); err != nil {
+						return err
+					}
+				}
+				if dec.IsObjectClose() {
+					objeb9d18a44784045d--
+				}
+			}
+		}
+	}
+	dec.Leave()
+
 	return nil
 }
 
 func (c *CBAvatar) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (c *CBAvatar) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
 
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
+
+func (c *CBAvatar) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("url", c.Url)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (c *CBAvatar) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := c.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (c *CBAvatar) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -136,23 +384,28 @@ func (c *CBAvatar) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj25c2506ffb3fa954 := 1; obj25c2506ffb3fa954 > 0; {
-				key479f117c516d8e9c, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen680b4e7c8b763a1b [1]uint64
+			for objb6d95526a41a9504 := 1; objb6d95526a41a9504 > 0; {
+				key1d49d4955c848621, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key479f117c516d8e9c {
+				switch key1d49d4955c848621 {
 				case "url":
-					value3da7bf4766834711, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen680b4e7c8b763a1b[0], 0, "url"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value6325253fec738dd7, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					c.Url = value3da7bf4766834711
+					c.Url = value6325253fec738dd7
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -160,32 +413,78 @@ func (c *CBAvatar) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj25c2506ffb3fa954--
+					objb6d95526a41a9504--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (c *CBGithub) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (c *CBGithub) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (c *CBGithub) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyInt("followers", c.Followers)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (c *CBGithub) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := c.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (c *CBGithub) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -196,23 +495,28 @@ func (c *CBGithub) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj80a51e4f8ebb2efe := 1; obj80a51e4f8ebb2efe > 0; {
-				key36b8a0acd131e3eb, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen0f0702448615bbda [1]uint64
+			for obja9e28bf921119c16 := 1; obja9e28bf921119c16 > 0; {
+				key08313f6a8eb668d2, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key36b8a0acd131e3eb {
+				switch key08313f6a8eb668d2 {
 				case "followers":
-					valuedf26cf2a50151851, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen0f0702448615bbda[0], 0, "followers"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value0bf5059875921e66, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					c.Followers = valuedf26cf2a50151851
+					c.Followers = value0bf5059875921e66
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -220,32 +524,57 @@ func (c *CBGithub) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj80a51e4f8ebb2efe--
+					obja9e28bf921119c16--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (c *CBGravatar) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
 
+func (c *CBGravatar) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
+
+func (c *CBGravatar) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.WriteKey("avatars")
 	if len(c.Avatars) == 0 {
 		enc.WriteNull()
 	} else {
 		enc.WriteByte('[')
-		for _, valued005f43f6167b95e := range c.Avatars {
+		for _, value8a5bdf2c7fc48445 := range c.Avatars {
 			enc.WriteComma()
-			if valued005f43f6167b95e == nil {
+			if value8a5bdf2c7fc48445 == nil {
 				enc.WriteNull()
 			} else {
 				enc.WriteByte('{')
-				enc.EncodeKeyString("url", valued005f43f6167b95e.Url)
+				enc.EncodeKeyString("url", value8a5bdf2c7fc48445.Url)
 
 				enc.WriteByte('}')
 			}
@@ -254,15 +583,36 @@ func (c *CBGravatar) MarshalJSON() ([]byte, error) {
 		enc.WriteByte(']')
 	}
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (c *CBGravatar) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := c.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (c *CBGravatar) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -273,17 +623,22 @@ func (c *CBGravatar) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj5b9f36235a361714 := 1; obj5b9f36235a361714 > 0; {
-				keycbd10cc9de9cf3cf, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seend6c52f5054e2d083 [1]uint64
+			for obj92d2572bcd0668d2 := 1; obj92d2572bcd0668d2 > 0; {
+				key6bf84c7174cb7476, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch keycbd10cc9de9cf3cf {
+				switch key6bf84c7174cb7476 {
 				case "avatars":
+					if dup, err := dec.Seen(&seend6c52f5054e2d083[0], 0, "avatars"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if char := dec.NextChar(); char == 'n' {
 						if err := dec.AssetNull(); err != nil {
 							return err
@@ -301,12 +656,12 @@ func (c *CBGravatar) UnmarshalJSON(data []byte) error {
 								c.Avatars = make(Avatars, 0, 8)
 							}
 
-							for array2d05ddacc209bbde := 1; array2d05ddacc209bbde > 0; {
-								var valuef81f5455746bc2b0 *CBAvatar
+							for array364cc3dbd968b0f7 := 1; array364cc3dbd968b0f7 > 0; {
+								var value172ed85794bb358b *CBAvatar
 								if dec.IsNull() {
-									valuef81f5455746bc2b0 = nil
+									value172ed85794bb358b = nil
 								} else {
-									valuef81f5455746bc2b0 = new(CBAvatar)
+									value172ed85794bb358b = new(CBAvatar)
 
 									if char := dec.NextChar(); char == 'n' {
 										if err := dec.AssetNull(); err != nil {
@@ -317,23 +672,28 @@ func (c *CBGravatar) UnmarshalJSON(data []byte) error {
 										return errors.NewParseError(dec.Char(), dec.Cursor())
 									} else {
 										dec.Next()
-										if dec.IsObjectClose() {
-											return nil
-										} else {
-											for obj0bddd90aa1252027 := 1; obj0bddd90aa1252027 > 0; {
-												keyf51f4d1a31b8e5f4, err := dec.NextKey()
+										if !dec.IsObjectClose() {
+											var seenff094279db1944eb [1]uint64
+											for obj0c3b525da1786f9f := 1; obj0c3b525da1786f9f > 0; {
+												keyd7a19d0f7bbacbe0, err := dec.NextKey()
 												if err != nil {
 													return err
 												}
 
-												switch keyf51f4d1a31b8e5f4 {
+												switch keyd7a19d0f7bbacbe0 {
 												case "url":
-													valuee66a867843143b0a, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seenff094279db1944eb[0], 0, "url"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value255aa5b7d44bec40, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													valuef81f5455746bc2b0.Url = valuee66a867843143b0a
+													value172ed85794bb358b.Url = value255aa5b7d44bec40
 
 												default:
 													if err := dec.SkipValue(); err != nil {
@@ -341,17 +701,21 @@ func (c *CBGravatar) UnmarshalJSON(data []byte) error {
 													}
 												}
 												if dec.IsObjectClose() {
-													obj0bddd90aa1252027--
+													obj0c3b525da1786f9f--
 												}
 											}
 										}
 									}
 								}
-								if valuef81f5455746bc2b0 != nil {
-									c.Avatars = append(c.Avatars, valuef81f5455746bc2b0)
+								if value172ed85794bb358b != nil {
+									c.Avatars = append(c.Avatars, value172ed85794bb358b)
+								}
+								if err := dec.CheckElements(len(c.Avatars)); err != nil {
+									return err
 								}
+
 								if dec.IsArrayClose() {
-									array2d05ddacc209bbde--
+									array364cc3dbd968b0f7--
 								}
 							}
 						}
@@ -363,32 +727,78 @@ func (c *CBGravatar) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj5b9f36235a361714--
+					obj92d2572bcd0668d2--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (c *CBName) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
 
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (c *CBName) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
+
+func (c *CBName) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("fullName", c.FullName)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (c *CBName) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := c.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (c *CBName) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -399,23 +809,28 @@ func (c *CBName) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj9e79be9d95a672bf := 1; obj9e79be9d95a672bf > 0; {
-				key180a14fdad276375, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen29b0223beea5f4f7 [1]uint64
+			for objf84c892b9bffd436 := 1; objf84c892b9bffd436 > 0; {
+				key4391f445d15afd42, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key180a14fdad276375 {
+				switch key4391f445d15afd42 {
 				case "fullName":
-					value67e352404d48481f, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen29b0223beea5f4f7[0], 0, "fullName"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value94040374f6924b98, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					c.FullName = value67e352404d48481f
+					c.FullName = value94040374f6924b98
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -423,19 +838,44 @@ func (c *CBName) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj9e79be9d95a672bf--
+					objf84c892b9bffd436--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (c *CBPerson) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
 
+	return data, nil
+}
+
+func (c *CBPerson) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
+
+func (c *CBPerson) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	if c.Name != nil {
 		enc.WriteKey("name")
@@ -459,13 +899,13 @@ func (c *CBPerson) MarshalJSON() ([]byte, error) {
 			enc.WriteNull()
 		} else {
 			enc.WriteByte('[')
-			for _, valuef6278b2f4812f467 := range c.Gravatar.Avatars {
+			for _, valuecbf8713f8d962d7c := range c.Gravatar.Avatars {
 				enc.WriteComma()
-				if valuef6278b2f4812f467 == nil {
+				if valuecbf8713f8d962d7c == nil {
 					enc.WriteNull()
 				} else {
 					enc.WriteByte('{')
-					enc.EncodeKeyString("url", valuef6278b2f4812f467.Url)
+					enc.EncodeKeyString("url", valuecbf8713f8d962d7c.Url)
 
 					enc.WriteByte('}')
 				}
@@ -476,15 +916,36 @@ func (c *CBPerson) MarshalJSON() ([]byte, error) {
 		enc.WriteByte('}')
 	}
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (c *CBPerson) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := c.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (c *CBPerson) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -495,17 +956,22 @@ func (c *CBPerson) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj330acb2dcdc0fcc3 := 1; obj330acb2dcdc0fcc3 > 0; {
-				key7cf04cb7985bc94c, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seencafccae3a61fb586 [1]uint64
+			for obj8d019192c24224e2 := 1; obj8d019192c24224e2 > 0; {
+				keyb14323a6bc8f9e7d, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key7cf04cb7985bc94c {
+				switch keyb14323a6bc8f9e7d {
 				case "name":
+					if dup, err := dec.Seen(&seencafccae3a61fb586[0], 0, "name"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						c.Name = nil
 					} else if !dec.IsObjectOpen() {
@@ -518,20 +984,27 @@ func (c *CBPerson) UnmarshalJSON(data []byte) error {
 								c.Name = new(CBName)
 							}
 
-							for obj3c18c088d96a309f := 1; obj3c18c088d96a309f > 0; {
-								key0a353dd24aebd4ce, err := dec.NextKey()
+							var seen3bea6f5b3af6de03 [1]uint64
+							for objf1d929333ff99393 := 1; objf1d929333ff99393 > 0; {
+								key74366c4719e43a1b, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key0a353dd24aebd4ce {
+								switch key74366c4719e43a1b {
 								case "fullName":
-									value8764073b887dfec3, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen3bea6f5b3af6de03[0], 0, "fullName"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value73981659a44ff17a, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									c.Name.FullName = value8764073b887dfec3
+									c.Name.FullName = value73981659a44ff17a
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -539,13 +1012,19 @@ func (c *CBPerson) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj3c18c088d96a309f--
+									objf1d929333ff99393--
 								}
 							}
 						}
 					}
 
 				case "github":
+					if dup, err := dec.Seen(&seencafccae3a61fb586[0], 1, "github"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						c.Github = nil
 					} else if !dec.IsObjectOpen() {
@@ -558,20 +1037,27 @@ func (c *CBPerson) UnmarshalJSON(data []byte) error {
 								c.Github = new(CBGithub)
 							}
 
-							for obj1f4cfd8289590769 := 1; obj1f4cfd8289590769 > 0; {
-								key3bb1e4092cc8dcd8, err := dec.NextKey()
+							var seen5849c6077dbb5722 [1]uint64
+							for obj4c7215a3b539eb1e := 1; obj4c7215a3b539eb1e > 0; {
+								keyf5717a289a266f97, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key3bb1e4092cc8dcd8 {
+								switch keyf5717a289a266f97 {
 								case "followers":
-									value216297a0c3967709, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seen5849c6077dbb5722[0], 0, "followers"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value0b4b373970115e82, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									c.Github.Followers = value216297a0c3967709
+									c.Github.Followers = value0b4b373970115e82
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -579,13 +1065,19 @@ func (c *CBPerson) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj1f4cfd8289590769--
+									obj4c7215a3b539eb1e--
 								}
 							}
 						}
 					}
 
 				case "gravatar":
+					if dup, err := dec.Seen(&seencafccae3a61fb586[0], 2, "gravatar"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						c.Gravatar = nil
 					} else if !dec.IsObjectOpen() {
@@ -598,14 +1090,21 @@ func (c *CBPerson) UnmarshalJSON(data []byte) error {
 								c.Gravatar = new(CBGravatar)
 							}
 
-							for objca2735d21c4e53a0 := 1; objca2735d21c4e53a0 > 0; {
-								key591ca2a9544713dd, err := dec.NextKey()
+							var seene4d7defa922daae7 [1]uint64
+							for objed6f4125c8fa7311 := 1; objed6f4125c8fa7311 > 0; {
+								key786667f7e936cd4f, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key591ca2a9544713dd {
+								switch key786667f7e936cd4f {
 								case "avatars":
+									if dup, err := dec.Seen(&seene4d7defa922daae7[0], 0, "avatars"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
 									if char := dec.NextChar(); char == 'n' {
 										if err := dec.AssetNull(); err != nil {
 											return err
@@ -623,12 +1122,12 @@ func (c *CBPerson) UnmarshalJSON(data []byte) error {
 												c.Gravatar.Avatars = make(Avatars, 0, 8)
 											}
 
-											for array2d790f0e24c33f29 := 1; array2d790f0e24c33f29 > 0; {
-												var value336f1bdfb42fadbf *CBAvatar
+											for array038367ad6145de1e := 1; array038367ad6145de1e > 0; {
+												var valuee8f4a8b0993ebdf8 *CBAvatar
 												if dec.IsNull() {
-													value336f1bdfb42fadbf = nil
+													valuee8f4a8b0993ebdf8 = nil
 												} else {
-													value336f1bdfb42fadbf = new(CBAvatar)
+													valuee8f4a8b0993ebdf8 = new(CBAvatar)
 
 													if char := dec.NextChar(); char == 'n' {
 														if err := dec.AssetNull(); err != nil {
@@ -639,23 +1138,28 @@ func (c *CBPerson) UnmarshalJSON(data []byte) error {
 														return errors.NewParseError(dec.Char(), dec.Cursor())
 													} else {
 														dec.Next()
-														if dec.IsObjectClose() {
-															return nil
-														} else {
-															for obj4100fd2e2df3082e := 1; obj4100fd2e2df3082e > 0; {
-																key29ae09fc034e19ed, err := dec.NextKey()
+														if !dec.IsObjectClose() {
+															var seenb04883e56a156a8d [1]uint64
+															for obj883a0ad8be9c3978 := 1; obj883a0ad8be9c3978 > 0; {
+																keye563afa467d49dec, err := dec.NextKey()
 																if err != nil {
 																	return err
 																}
 
-																switch key29ae09fc034e19ed {
+																switch keye563afa467d49dec {
 																case "url":
-																	valuea928b0678d5ffb77, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seenb04883e56a156a8d[0], 0, "url"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value6a40e9a1d007f033, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	value336f1bdfb42fadbf.Url = valuea928b0678d5ffb77
+																	valuee8f4a8b0993ebdf8.Url = value6a40e9a1d007f033
 
 																default:
 																	if err := dec.SkipValue(); err != nil {
@@ -663,17 +1167,21 @@ func (c *CBPerson) UnmarshalJSON(data []byte) error {
 																	}
 																}
 																if dec.IsObjectClose() {
-																	obj4100fd2e2df3082e--
+																	obj883a0ad8be9c3978--
 																}
 															}
 														}
 													}
 												}
-												if value336f1bdfb42fadbf != nil {
-													c.Gravatar.Avatars = append(c.Gravatar.Avatars, value336f1bdfb42fadbf)
+												if valuee8f4a8b0993ebdf8 != nil {
+													c.Gravatar.Avatars = append(c.Gravatar.Avatars, valuee8f4a8b0993ebdf8)
 												}
+												if err := dec.CheckElements(len(c.Gravatar.Avatars)); err != nil {
+													return err
+												}
+
 												if dec.IsArrayClose() {
-													array2d790f0e24c33f29--
+													array038367ad6145de1e--
 												}
 											}
 										}
@@ -685,7 +1193,7 @@ func (c *CBPerson) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									objca2735d21c4e53a0--
+									objed6f4125c8fa7311--
 								}
 							}
 						}
@@ -697,19 +1205,44 @@ func (c *CBPerson) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj330acb2dcdc0fcc3--
+					obj8d019192c24224e2--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (c *Client) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
 
+	return data, nil
+}
+
+func (c *Client) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := c.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
+
+func (c *Client) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyInt("bytes", c.Bytes)
 
@@ -718,15 +1251,36 @@ func (c *Client) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyInt("port", c.Port)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (c *Client) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := c.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (c *Client) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -737,39 +1291,56 @@ func (c *Client) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj15d22079f70a9528 := 1; obj15d22079f70a9528 > 0; {
-				key5961052aca6dc84f, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen9f8e4da643010522 [1]uint64
+			for objc2823061bdd0eaa5 := 1; objc2823061bdd0eaa5 > 0; {
+				key0d0b29688b734b8e, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key5961052aca6dc84f {
+				switch key0d0b29688b734b8e {
 				case "bytes":
-					valuef489b5d3227d8e4c, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen9f8e4da643010522[0], 0, "bytes"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuea0f3ca9936e8461f, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					c.Bytes = valuef489b5d3227d8e4c
+					c.Bytes = valuea0f3ca9936e8461f
 
 				case "ip":
-					value78d3dd3b5df2cb7e, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen9f8e4da643010522[0], 1, "ip"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value10d77c96ea80a7a6, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					c.IP = value78d3dd3b5df2cb7e
+					c.IP = value10d77c96ea80a7a6
 
 				case "port":
-					valuea0443cb4da636cf2, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen9f8e4da643010522[0], 2, "port"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value65f606f6a63b7f3d, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					c.Port = valuea0443cb4da636cf2
+					c.Port = value65f606f6a63b7f3d
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -777,34 +1348,80 @@ func (c *Client) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj15d22079f70a9528--
+					objc2823061bdd0eaa5--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (d *DSTopic) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := d.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
 
+	return data, nil
+}
+
+func (d *DSTopic) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := d.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
+
+func (d *DSTopic) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyInt("id", d.Id)
 
 	enc.EncodeKeyString("slug", d.Slug)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (d *DSTopic) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := d.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (d *DSTopic) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -815,31 +1432,42 @@ func (d *DSTopic) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for objb050ebaf6c6a4ece := 1; objb050ebaf6c6a4ece > 0; {
-				key416875a04432e079, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen0f26686d9bf2fb26 [1]uint64
+			for objfd2567c18979e4d6 := 1; objfd2567c18979e4d6 > 0; {
+				keyc901ff354cde1607, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key416875a04432e079 {
+				switch keyc901ff354cde1607 {
 				case "id":
-					value397cabe591511d23, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen0f26686d9bf2fb26[0], 0, "id"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valueee294b39f32b7c78, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					d.Id = value397cabe591511d23
+					d.Id = valueee294b39f32b7c78
 
 				case "slug":
-					value1fad33d5eb64ca26, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen0f26686d9bf2fb26[0], 1, "slug"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value22ba64f84ab43ca0, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					d.Slug = value1fad33d5eb64ca26
+					d.Slug = value22ba64f84ab43ca0
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -847,34 +1475,59 @@ func (d *DSTopic) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					objb050ebaf6c6a4ece--
+					objfd2567c18979e4d6--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (d *DSTopicsList) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := d.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (d *DSTopicsList) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := d.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
 
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
+
+func (d *DSTopicsList) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.WriteKey("topics")
 	if len(d.Topics) == 0 {
 		enc.WriteNull()
 	} else {
 		enc.WriteByte('[')
-		for _, value1c6b5a26d59541c7 := range d.Topics {
+		for _, valuec6e6b91c1fd3be89 := range d.Topics {
 			enc.WriteComma()
-			if value1c6b5a26d59541c7 == nil {
+			if valuec6e6b91c1fd3be89 == nil {
 				enc.WriteNull()
 			} else {
 				enc.WriteByte('{')
-				enc.EncodeKeyInt("id", value1c6b5a26d59541c7.Id)
+				enc.EncodeKeyInt("id", valuec6e6b91c1fd3be89.Id)
 
-				enc.EncodeKeyString("slug", value1c6b5a26d59541c7.Slug)
+				enc.EncodeKeyString("slug", valuec6e6b91c1fd3be89.Slug)
 
 				enc.WriteByte('}')
 			}
@@ -885,15 +1538,36 @@ func (d *DSTopicsList) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyString("more_topics_url", d.MoreTopicsUrl)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (d *DSTopicsList) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := d.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (d *DSTopicsList) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -904,17 +1578,22 @@ func (d *DSTopicsList) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj0afe2d24e851ef65 := 1; obj0afe2d24e851ef65 > 0; {
-				key486d9a7644e7ca36, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seena369012db92d184f [1]uint64
+			for obj90434179d3af4491 := 1; obj90434179d3af4491 > 0; {
+				keyc39d1734ff571642, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key486d9a7644e7ca36 {
+				switch keyc39d1734ff571642 {
 				case "topics":
+					if dup, err := dec.Seen(&seena369012db92d184f[0], 0, "topics"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if char := dec.NextChar(); char == 'n' {
 						if err := dec.AssetNull(); err != nil {
 							return err
@@ -932,12 +1611,12 @@ func (d *DSTopicsList) UnmarshalJSON(data []byte) error {
 								d.Topics = make(DSTopics, 0, 8)
 							}
 
-							for arrayee548f791593277c := 1; arrayee548f791593277c > 0; {
-								var value4272cfba0cec690b *DSTopic
+							for array8953bb6865fcf92b := 1; array8953bb6865fcf92b > 0; {
+								var value0c3a17c9028be991 *DSTopic
 								if dec.IsNull() {
-									value4272cfba0cec690b = nil
+									value0c3a17c9028be991 = nil
 								} else {
-									value4272cfba0cec690b = new(DSTopic)
+									value0c3a17c9028be991 = new(DSTopic)
 
 									if char := dec.NextChar(); char == 'n' {
 										if err := dec.AssetNull(); err != nil {
@@ -948,31 +1627,42 @@ func (d *DSTopicsList) UnmarshalJSON(data []byte) error {
 										return errors.NewParseError(dec.Char(), dec.Cursor())
 									} else {
 										dec.Next()
-										if dec.IsObjectClose() {
-											return nil
-										} else {
-											for obj121a201c76d1d553 := 1; obj121a201c76d1d553 > 0; {
-												key8f69e6df86174849, err := dec.NextKey()
+										if !dec.IsObjectClose() {
+											var seen0979d1830356f2a5 [1]uint64
+											for obj4eb7649c6c934780 := 1; obj4eb7649c6c934780 > 0; {
+												key4c3deab2a4b4475d, err := dec.NextKey()
 												if err != nil {
 													return err
 												}
 
-												switch key8f69e6df86174849 {
+												switch key4c3deab2a4b4475d {
 												case "id":
-													value290c73771108e994, err := dec.DecodeInt()
+													if dup, err := dec.Seen(&seen0979d1830356f2a5[0], 0, "id"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value63afbe8fb56987c7, err := dec.DecodeInt()
 													if err != nil {
 														return err
 													}
 
-													value4272cfba0cec690b.Id = value290c73771108e994
+													value0c3a17c9028be991.Id = value63afbe8fb56987c7
 
 												case "slug":
-													valuef07b21b479d964d8, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seen0979d1830356f2a5[0], 1, "slug"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value7f5818526f1814be, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													value4272cfba0cec690b.Slug = valuef07b21b479d964d8
+													value0c3a17c9028be991.Slug = value7f5818526f1814be
 
 												default:
 													if err := dec.SkipValue(); err != nil {
@@ -980,29 +1670,39 @@ func (d *DSTopicsList) UnmarshalJSON(data []byte) error {
 													}
 												}
 												if dec.IsObjectClose() {
-													obj121a201c76d1d553--
+													obj4eb7649c6c934780--
 												}
 											}
 										}
 									}
 								}
-								if value4272cfba0cec690b != nil {
-									d.Topics = append(d.Topics, value4272cfba0cec690b)
+								if value0c3a17c9028be991 != nil {
+									d.Topics = append(d.Topics, value0c3a17c9028be991)
 								}
+								if err := dec.CheckElements(len(d.Topics)); err != nil {
+									return err
+								}
+
 								if dec.IsArrayClose() {
-									arrayee548f791593277c--
+									array8953bb6865fcf92b--
 								}
 							}
 						}
 					}
 
 				case "more_topics_url":
-					valuefe69ab0556501b42, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seena369012db92d184f[0], 1, "more_topics_url"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value823350eab13935f3, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					d.MoreTopicsUrl = valuefe69ab0556501b42
+					d.MoreTopicsUrl = value823350eab13935f3
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -1010,32 +1710,78 @@ func (d *DSTopicsList) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj0afe2d24e851ef65--
+					obj90434179d3af4491--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (d *DSUser) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := d.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (d *DSUser) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := d.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
 
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
+
+func (d *DSUser) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("username", d.Username)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (d *DSUser) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := d.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (d *DSUser) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -1046,23 +1792,28 @@ func (d *DSUser) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for objb5f542c0f8400bbe := 1; objb5f542c0f8400bbe > 0; {
-				keyac982fdee3adad40, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seenf78ae151c0075592 [1]uint64
+			for obj1d84484517e924ae := 1; obj1d84484517e924ae > 0; {
+				key5836b7075885650c, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch keyac982fdee3adad40 {
+				switch key5836b7075885650c {
 				case "username":
-					value7650506db66801ea, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenf78ae151c0075592[0], 0, "username"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value30ec29a3703934bf, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					d.Username = value7650506db66801ea
+					d.Username = value30ec29a3703934bf
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -1070,19 +1821,44 @@ func (d *DSUser) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					objb5f542c0f8400bbe--
+					obj1d84484517e924ae--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (d *Destination) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := d.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (d *Destination) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := d.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (d *Destination) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("ip", d.IP)
 
@@ -1093,15 +1869,36 @@ func (d *Destination) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyInt("bytes", d.Bytes)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (d *Destination) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := d.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (d *Destination) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -1112,47 +1909,70 @@ func (d *Destination) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj2bc3042f85502d72 := 1; obj2bc3042f85502d72 > 0; {
-				key25735d2abdf35b03, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seena77e758579ea3dfe [1]uint64
+			for obj50a28da102975ded := 1; obj50a28da102975ded > 0; {
+				key4136abf752b3b827, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key25735d2abdf35b03 {
+				switch key4136abf752b3b827 {
 				case "ip":
-					value3f0a6601c61d1781, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seena77e758579ea3dfe[0], 0, "ip"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value1d03e944b3c9db36, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					d.IP = value3f0a6601c61d1781
+					d.IP = value1d03e944b3c9db36
 
 				case "port":
-					valueaf2527e527e65b95, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seena77e758579ea3dfe[0], 1, "port"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value6b75045f8efd69d2, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					d.Port = valueaf2527e527e65b95
+					d.Port = value6b75045f8efd69d2
 
 				case "domain":
-					value2fe0ff70e9b8972a, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seena77e758579ea3dfe[0], 2, "domain"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value2ae5411947cb553d, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					d.Domain = value2fe0ff70e9b8972a
+					d.Domain = value2ae5411947cb553d
 
 				case "bytes":
-					value594b479787555dbf, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seena77e758579ea3dfe[0], 3, "bytes"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value7694267aef4ebcea, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					d.Bytes = value594b479787555dbf
+					d.Bytes = value7694267aef4ebcea
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -1160,32 +1980,78 @@ func (d *Destination) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj2bc3042f85502d72--
+					obj50a28da102975ded--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (e *Ecs) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := e.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (e *Ecs) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := e.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (e *Ecs) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("version", e.Version)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (e *Ecs) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := e.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (e *Ecs) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -1196,23 +2062,28 @@ func (e *Ecs) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj48692a714a5483a4 := 1; obj48692a714a5483a4 > 0; {
-				keyc6ed9d0ff649dbad, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen84f57e37caac6e33 [1]uint64
+			for obj406b32d6108bd685 := 1; obj406b32d6108bd685 > 0; {
+				keyfeaa3263a3994370, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch keyc6ed9d0ff649dbad {
+				switch keyfeaa3263a3994370 {
 				case "version":
-					value9c1455162fc21310, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen84f57e37caac6e33[0], 0, "version"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value24ba9c9b14678a27, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					e.Version = value9c1455162fc21310
+					e.Version = value24ba9c9b14678a27
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -1220,36 +2091,61 @@ func (e *Ecs) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj48692a714a5483a4--
+					obj406b32d6108bd685--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (e *Event) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := e.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (e *Event) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := e.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (e *Event) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyInt("duration", e.Duration)
 
 	enc.WriteKey("start")
-	datadfde38bab61e67d8, err := e.Start.MarshalJSON()
+	data4f01a910ae295f6e, err := e.Start.MarshalJSON()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	enc.WriteBytes(datadfde38bab61e67d8)
+	enc.WriteBytes(data4f01a910ae295f6e)
 	enc.WriteKey("end")
-	dataaae5e7129f51b6ec, err := e.End.MarshalJSON()
+	datafbfe5f5abf44ccde, err := e.End.MarshalJSON()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	enc.WriteBytes(dataaae5e7129f51b6ec)
+	enc.WriteBytes(datafbfe5f5abf44ccde)
 	enc.EncodeKeyString("kind", e.Kind)
 
 	enc.EncodeKeyString("category", e.Category)
@@ -1257,15 +2153,36 @@ func (e *Event) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyString("dataset", e.Dataset)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (e *Event) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := e.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (e *Event) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -1276,67 +2193,102 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj04544cadb6306426 := 1; obj04544cadb6306426 > 0; {
-				keyafc0d7b7c3e9f8e8, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen006f28295d7d3906 [1]uint64
+			for obj263b5606633e2bf0 := 1; obj263b5606633e2bf0 > 0; {
+				key9f01a239c4365854, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch keyafc0d7b7c3e9f8e8 {
+				switch key9f01a239c4365854 {
 				case "duration":
-					valuec599569e5d84a052, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen006f28295d7d3906[0], 0, "duration"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuec3af7f6b41d631f9, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					e.Duration = valuec599569e5d84a052
+					e.Duration = valuec3af7f6b41d631f9
 
 				case "start":
-					data337169e9fe122205, err := dec.ReadValue()
+					if dup, err := dec.Seen(&seen006f28295d7d3906[0], 1, "start"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					data2b9a8d12f4125732, err := dec.ReadValue()
 					if err != nil {
-						return nil
+						return err
 					}
 
-					if err := e.Start.UnmarshalJSON(data337169e9fe122205); err != nil {
+					if err := e.Start.UnmarshalJSON(data2b9a8d12f4125732); err != nil {
 						return err
 					}
 
 				case "end":
-					data1edca81ce8436d34, err := dec.ReadValue()
+					if dup, err := dec.Seen(&seen006f28295d7d3906[0], 2, "end"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					data5fff332f7576b062, err := dec.ReadValue()
 					if err != nil {
-						return nil
+						return err
 					}
 
-					if err := e.End.UnmarshalJSON(data1edca81ce8436d34); err != nil {
+					if err := e.End.UnmarshalJSON(data5fff332f7576b062); err != nil {
 						return err
 					}
 
 				case "kind":
-					value5a346d5713680078, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen006f28295d7d3906[0], 3, "kind"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value0556304a3e3eae14, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					e.Kind = value5a346d5713680078
+					e.Kind = value0556304a3e3eae14
 
 				case "category":
-					value46bf44f6ebeca303, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen006f28295d7d3906[0], 4, "category"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuec28d0cea39d2901a, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					e.Category = value46bf44f6ebeca303
+					e.Category = valuec28d0cea39d2901a
 
 				case "dataset":
-					valuea0189f14232fcebb, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen006f28295d7d3906[0], 5, "dataset"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value52720da85ca1e4b3, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					e.Dataset = valuea0189f14232fcebb
+					e.Dataset = value52720da85ca1e4b3
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -1344,19 +2296,44 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj04544cadb6306426--
+					obj263b5606633e2bf0--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (h *HTTP) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := h.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (h *HTTP) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := h.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
 
+	return dst, nil
+}
+
+func (h *HTTP) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.WriteKey("response")
 	enc.WriteByte('{')
@@ -1430,17 +2407,45 @@ func (h *HTTP) MarshalJSON() ([]byte, error) {
 	enc.WriteByte('}')
 	enc.EncodeKeyString("method", h.Request.Method)
 
+	enc.WriteKey("body")
+	enc.WriteByte('{')
+	enc.EncodeKeyString("content", h.Request.Body.Content)
+
+	enc.EncodeKeyInt("bytes", h.Request.Body.Bytes)
+
+	enc.WriteByte('}')
 	enc.WriteByte('}')
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (h *HTTP) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := h.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (h *HTTP) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -1451,17 +2456,22 @@ func (h *HTTP) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for objdb246b43881c58c8 := 1; objdb246b43881c58c8 > 0; {
-				key3f78a992efb05085, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen62f2f54fc00e09d6 [1]uint64
+			for obj8eaf3f44c6c6ef83 := 1; obj8eaf3f44c6c6ef83 > 0; {
+				keyfc25640854c15dfc, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key3f78a992efb05085 {
+				switch keyfc25640854c15dfc {
 				case "response":
+					if dup, err := dec.Seen(&seen62f2f54fc00e09d6[0], 0, "response"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						h.Response = Response{}
 					} else if !dec.IsObjectOpen() {
@@ -1470,22 +2480,35 @@ func (h *HTTP) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							h.Response = Response{}
 						} else {
-							for obj07187d24de4a5c90 := 1; obj07187d24de4a5c90 > 0; {
-								keyecb7c68609070658, err := dec.NextKey()
+							var seenba53ab705b18db94 [1]uint64
+							for objacaa8a2cecce5a3a := 1; objacaa8a2cecce5a3a > 0; {
+								keyb4d338a5143e6340, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch keyecb7c68609070658 {
+								switch keyb4d338a5143e6340 {
 								case "status_code":
-									value7334781abe6c8204, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seenba53ab705b18db94[0], 0, "status_code"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuea3f79be1072fb63c, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									h.Response.StatusCode = value7334781abe6c8204
+									h.Response.StatusCode = valuea3f79be1072fb63c
 
 								case "body":
+									if dup, err := dec.Seen(&seenba53ab705b18db94[0], 1, "body"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
 									if dec.IsNull() {
 										h.Response.Body = Body{}
 									} else if !dec.IsObjectOpen() {
@@ -1494,28 +2517,41 @@ func (h *HTTP) UnmarshalJSON(data []byte) error {
 										if dec.IsObjectClose() {
 											h.Response.Body = Body{}
 										} else {
-											for obj72092b83e83d241a := 1; obj72092b83e83d241a > 0; {
-												key0ba7b758f74b66de, err := dec.NextKey()
+											var seene9e2a9f3fb4ffb00 [1]uint64
+											for obj35d6042c4160f38e := 1; obj35d6042c4160f38e > 0; {
+												key19b454d522b5ffa1, err := dec.NextKey()
 												if err != nil {
 													return err
 												}
 
-												switch key0ba7b758f74b66de {
+												switch key19b454d522b5ffa1 {
 												case "content":
-													valuecbc2d50e17431e40, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seene9e2a9f3fb4ffb00[0], 0, "content"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valuea7960732ca52cf53, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Response.Body.Content = valuecbc2d50e17431e40
+													h.Response.Body.Content = valuea7960732ca52cf53
 
 												case "bytes":
-													value0af6db6e4e694752, err := dec.DecodeInt()
+													if dup, err := dec.Seen(&seene9e2a9f3fb4ffb00[0], 1, "bytes"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valuec3f520c889b79bf5, err := dec.DecodeInt()
 													if err != nil {
 														return err
 													}
 
-													h.Response.Body.Bytes = value0af6db6e4e694752
+													h.Response.Body.Bytes = valuec3f520c889b79bf5
 
 												default:
 													if err := dec.SkipValue(); err != nil {
@@ -1523,21 +2559,33 @@ func (h *HTTP) UnmarshalJSON(data []byte) error {
 													}
 												}
 												if dec.IsObjectClose() {
-													obj72092b83e83d241a--
+													obj35d6042c4160f38e--
 												}
 											}
 										}
 									}
 
 								case "bytes":
-									value1851e0d715d11ba3, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seenba53ab705b18db94[0], 2, "bytes"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value04cfb57c7601232d, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									h.Response.Bytes = value1851e0d715d11ba3
+									h.Response.Bytes = value04cfb57c7601232d
 
 								case "headers":
+									if dup, err := dec.Seen(&seenba53ab705b18db94[0], 3, "headers"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
 									if dec.IsNull() {
 										h.Response.Headers = ResponseHeaders{}
 									} else if !dec.IsObjectOpen() {
@@ -1546,68 +2594,111 @@ func (h *HTTP) UnmarshalJSON(data []byte) error {
 										if dec.IsObjectClose() {
 											h.Response.Headers = ResponseHeaders{}
 										} else {
-											for obj08e9a5f37ce11465 := 1; obj08e9a5f37ce11465 > 0; {
-												keydef59697c375f2e1, err := dec.NextKey()
+											var seene25c27741d3f6c62 [1]uint64
+											for obj589baccea9d6e263 := 1; obj589baccea9d6e263 > 0; {
+												keycbbb15d9afbcbf7f, err := dec.NextKey()
 												if err != nil {
 													return err
 												}
 
-												switch keydef59697c375f2e1 {
+												switch keycbbb15d9afbcbf7f {
 												case "content-length":
-													value339f9c5f797befeb, err := dec.DecodeInt()
+													if dup, err := dec.Seen(&seene25c27741d3f6c62[0], 0, "content-length"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valuec2e2cdcf233438bf, err := dec.DecodeInt()
 													if err != nil {
 														return err
 													}
 
-													h.Response.Headers.ContentLength = value339f9c5f797befeb
+													h.Response.Headers.ContentLength = valuec2e2cdcf233438bf
 
 												case "transfer-encoding":
-													value8bd3040cc8d319f5, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seene25c27741d3f6c62[0], 1, "transfer-encoding"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value1774ace7709a4f09, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Response.Headers.TransferEncoding = value8bd3040cc8d319f5
+													h.Response.Headers.TransferEncoding = value1774ace7709a4f09
 
 												case "connection":
-													value6cc75ac7e4b89479, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seene25c27741d3f6c62[0], 2, "connection"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value1e9a83fdeae0ec55, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Response.Headers.Connection = value6cc75ac7e4b89479
+													h.Response.Headers.Connection = value1e9a83fdeae0ec55
 
 												case "cache-control":
-													valuee0af7dc0be396e8e, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seene25c27741d3f6c62[0], 3, "cache-control"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valueeb233a9b5394cb3c, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Response.Headers.CacheControl = valuee0af7dc0be396e8e
+													h.Response.Headers.CacheControl = valueeb233a9b5394cb3c
 
 												case "pragma":
-													valueb202a18634a8bb3d, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seene25c27741d3f6c62[0], 4, "pragma"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value7856b546d313c8a3, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Response.Headers.Pragma = valueb202a18634a8bb3d
+													h.Response.Headers.Pragma = value7856b546d313c8a3
 
 												case "server":
-													value00abe08b31d410f1, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seene25c27741d3f6c62[0], 5, "server"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valueb4c1c0e05447f4ba, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Response.Headers.Server = value00abe08b31d410f1
+													h.Response.Headers.Server = valueb4c1c0e05447f4ba
 
 												case "date":
-													value0453cc439034ad8c, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seene25c27741d3f6c62[0], 6, "date"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value370eb36dbcfdec90, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Response.Headers.Date = value0453cc439034ad8c
+													h.Response.Headers.Date = value370eb36dbcfdec90
 
 												default:
 													if err := dec.SkipValue(); err != nil {
@@ -1615,19 +2706,25 @@ func (h *HTTP) UnmarshalJSON(data []byte) error {
 													}
 												}
 												if dec.IsObjectClose() {
-													obj08e9a5f37ce11465--
+													obj589baccea9d6e263--
 												}
 											}
 										}
 									}
 
 								case "status_phrase":
-									valuec3b4f1709f8d7408, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seenba53ab705b18db94[0], 4, "status_phrase"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valueb302dcdc3b9ef522, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									h.Response.StatusPhrase = valuec3b4f1709f8d7408
+									h.Response.StatusPhrase = valueb302dcdc3b9ef522
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -1635,21 +2732,33 @@ func (h *HTTP) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj07187d24de4a5c90--
+									objacaa8a2cecce5a3a--
 								}
 							}
 						}
 					}
 
 				case "version":
-					value4ef4948ba5a66913, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen62f2f54fc00e09d6[0], 1, "version"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuee2a6f1ed0afec1f8, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					h.Version = value4ef4948ba5a66913
+					h.Version = valuee2a6f1ed0afec1f8
 
 				case "request":
+					if dup, err := dec.Seen(&seen62f2f54fc00e09d6[0], 2, "request"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						h.Request = Request{}
 					} else if !dec.IsObjectOpen() {
@@ -1658,30 +2767,49 @@ func (h *HTTP) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							h.Request = Request{}
 						} else {
-							for obj27628d2defe91efd := 1; obj27628d2defe91efd > 0; {
-								key2ea660c7f3267db9, err := dec.NextKey()
+							var seen717d3a748a58677a [1]uint64
+							for obje20faabedf6b162e := 1; obje20faabedf6b162e > 0; {
+								key0c56348f8921a266, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key2ea660c7f3267db9 {
+								switch key0c56348f8921a266 {
 								case "referrer":
-									value98614fade8528e4b, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen717d3a748a58677a[0], 0, "referrer"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valueba53af19779cb294, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									h.Request.Referrer = value98614fade8528e4b
+									h.Request.Referrer = valueba53af19779cb294
 
 								case "bytes":
-									value66f54c9df852428c, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seen717d3a748a58677a[0], 1, "bytes"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value8b6570ffa0b77396, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									h.Request.Bytes = value66f54c9df852428c
+									h.Request.Bytes = value8b6570ffa0b77396
 
 								case "headers":
+									if dup, err := dec.Seen(&seen717d3a748a58677a[0], 2, "headers"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
 									if dec.IsNull() {
 										h.Request.Headers = RequestHeaders{}
 									} else if !dec.IsObjectOpen() {
@@ -1690,116 +2818,272 @@ func (h *HTTP) UnmarshalJSON(data []byte) error {
 										if dec.IsObjectClose() {
 											h.Request.Headers = RequestHeaders{}
 										} else {
-											for objb40ef2b1be8c30ae := 1; objb40ef2b1be8c30ae > 0; {
-												key66ac56240024bc0b, err := dec.NextKey()
+											var seen4e3ad29b5125210f [1]uint64
+											for obj3c130ad797ddeafe := 1; obj3c130ad797ddeafe > 0; {
+												key0ef1c314090f07c7, err := dec.NextKey()
 												if err != nil {
 													return err
 												}
 
-												switch key66ac56240024bc0b {
+												switch key0ef1c314090f07c7 {
 												case "referer":
-													value259ed7d3182513c6, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 0, "referer"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valuec0b7413ef110bd58, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Request.Headers.Referer = value259ed7d3182513c6
+													h.Request.Headers.Referer = valuec0b7413ef110bd58
 
 												case "x-requested-with":
-													valuef5e969cdeb96cdff, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 1, "x-requested-with"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valueb00ce73bff706f7f, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Request.Headers.XRequestedWith = valuef5e969cdeb96cdff
+													h.Request.Headers.XRequestedWith = valueb00ce73bff706f7f
 
 												case "yz_client_ip":
-													valuef0a5c5482110d5f5, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 2, "yz_client_ip"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valuef4b6f44090a32711, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Request.Headers.YzClientIP = valuef0a5c5482110d5f5
+													h.Request.Headers.YzClientIP = valuef4b6f44090a32711
 
 												case "user-agent":
-													value89bdb5379408fc9d, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 3, "user-agent"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valuef3208e4e4b89cb51, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Request.Headers.UserAgent = value89bdb5379408fc9d
+													h.Request.Headers.UserAgent = valuef3208e4e4b89cb51
 
 												case "accept-language":
-													value87c71585feb54486, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 4, "accept-language"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value65ce64002cbd9c28, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Request.Headers.AcceptLanguage = value87c71585feb54486
+													h.Request.Headers.AcceptLanguage = value65ce64002cbd9c28
 
 												case "content-length":
-													valuec92e94eaa540f6ef, err := dec.DecodeInt()
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 5, "content-length"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value87aa113df2468928, err := dec.DecodeInt()
 													if err != nil {
 														return err
 													}
 
-													h.Request.Headers.ContentLength = valuec92e94eaa540f6ef
+													h.Request.Headers.ContentLength = value87aa113df2468928
 
 												case "x-real-ip":
-													value925410902938a4f6, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 6, "x-real-ip"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valued5a23b9ca740f80c, err := dec.DecodeString()
+													if err != nil {
+														return err
+													}
+
+													h.Request.Headers.XRealIP = valued5a23b9ca740f80c
+
+												case "pragma":
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 7, "pragma"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value9382d9c6034ad296, err := dec.DecodeString()
+													if err != nil {
+														return err
+													}
+
+													h.Request.Headers.Pragma = value9382d9c6034ad296
+
+												case "connection":
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 8, "connection"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value0c796503e1ce2217, err := dec.DecodeString()
+													if err != nil {
+														return err
+													}
+
+													h.Request.Headers.Connection = value0c796503e1ce2217
+
+												case "accept":
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 9, "accept"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value25f50caf1fbfe831, err := dec.DecodeString()
+													if err != nil {
+														return err
+													}
+
+													h.Request.Headers.Accept = value25f50caf1fbfe831
+
+												case "host":
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 10, "host"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valueb10b7bf5b15c47a5, err := dec.DecodeString()
+													if err != nil {
+														return err
+													}
+
+													h.Request.Headers.Host = valueb10b7bf5b15c47a5
+
+												case "x-forwarded-for":
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 11, "x-forwarded-for"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value3dbf8e7dcafc9e13, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Request.Headers.XRealIP = value925410902938a4f6
+													h.Request.Headers.XForwardedFor = value3dbf8e7dcafc9e13
+
+												case "content-type":
+													if dup, err := dec.Seen(&seen4e3ad29b5125210f[0], 12, "content-type"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
 
-												case "pragma":
-													valuea08fa75741e38a6a, err := dec.DecodeString()
+													value8647a4b44ed4bce9, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Request.Headers.Pragma = valuea08fa75741e38a6a
+													h.Request.Headers.ContentType = value8647a4b44ed4bce9
 
-												case "connection":
-													valueddbb892c0a433ce6, err := dec.DecodeString()
-													if err != nil {
+												default:
+													if err := dec.SkipValue(); err != nil {
 														return err
 													}
+												}
+												if dec.IsObjectClose() {
+													obj3c130ad797ddeafe--
+												}
+											}
+										}
+									}
 
-													h.Request.Headers.Connection = valueddbb892c0a433ce6
+								case "method":
+									if dup, err := dec.Seen(&seen717d3a748a58677a[0], 3, "method"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
 
-												case "accept":
-													value2fc532f9968818a0, err := dec.DecodeString()
-													if err != nil {
+									value64ed47f74aa59446, err := dec.DecodeString()
+									if err != nil {
+										return err
+									}
+
+									h.Request.Method = value64ed47f74aa59446
+
+								case "body":
+									if dup, err := dec.Seen(&seen717d3a748a58677a[0], 4, "body"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									if dec.IsNull() {
+										h.Request.Body = Body{}
+									} else if !dec.IsObjectOpen() {
+										return errors.NewParseError(dec.Char(), dec.Cursor())
+									} else {
+										if dec.IsObjectClose() {
+											h.Request.Body = Body{}
+										} else {
+											var seenac476c9fb03fc922 [1]uint64
+											for obj8ced323cb76f0d3f := 1; obj8ced323cb76f0d3f > 0; {
+												key8fbae88fd580663a, err := dec.NextKey()
+												if err != nil {
+													return err
+												}
+
+												switch key8fbae88fd580663a {
+												case "content":
+													if dup, err := dec.Seen(&seenac476c9fb03fc922[0], 0, "content"); err != nil {
 														return err
+													} else if dup {
+														break
 													}
 
-													h.Request.Headers.Accept = value2fc532f9968818a0
-
-												case "host":
-													valued887cdfda62c6d77, err := dec.DecodeString()
+													value3b584c62316492b4, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													h.Request.Headers.Host = valued887cdfda62c6d77
+													h.Request.Body.Content = value3b584c62316492b4
 
-												case "x-forwarded-for":
-													value4519fbf515737d45, err := dec.DecodeString()
-													if err != nil {
+												case "bytes":
+													if dup, err := dec.Seen(&seenac476c9fb03fc922[0], 1, "bytes"); err != nil {
 														return err
+													} else if dup {
+														break
 													}
 
-													h.Request.Headers.XForwardedFor = value4519fbf515737d45
-
-												case "content-type":
-													value965c5740c0225d34, err := dec.DecodeString()
+													value9753b5d5027ce15a, err := dec.DecodeInt()
 													if err != nil {
 														return err
 													}
 
-													h.Request.Headers.ContentType = value965c5740c0225d34
+													h.Request.Body.Bytes = value9753b5d5027ce15a
 
 												default:
 													if err := dec.SkipValue(); err != nil {
@@ -1807,27 +3091,19 @@ func (h *HTTP) UnmarshalJSON(data []byte) error {
 													}
 												}
 												if dec.IsObjectClose() {
-													objb40ef2b1be8c30ae--
+													obj8ced323cb76f0d3f--
 												}
 											}
 										}
 									}
 
-								case "method":
-									valuec03e9497d810b9fb, err := dec.DecodeString()
-									if err != nil {
-										return err
-									}
-
-									h.Request.Method = valuec03e9497d810b9fb
-
 								default:
 									if err := dec.SkipValue(); err != nil {
 										return err
 									}
 								}
 								if dec.IsObjectClose() {
-									obj27628d2defe91efd--
+									obje20faabedf6b162e--
 								}
 							}
 						}
@@ -1839,32 +3115,78 @@ func (h *HTTP) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					objdb246b43881c58c8--
+					obj8eaf3f44c6c6ef83--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (h *Host) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := h.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (h *Host) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := h.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (h *Host) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("name", h.Name)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (h *Host) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := h.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (h *Host) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -1875,23 +3197,28 @@ func (h *Host) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj7830d722dfff6d0d := 1; obj7830d722dfff6d0d > 0; {
-				key6b73673c68c5b3f5, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen77f2bf4f0152e5d4 [1]uint64
+			for obj4f0a58250d8fb50e := 1; obj4f0a58250d8fb50e > 0; {
+				key9435807f9d4b97be, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key6b73673c68c5b3f5 {
+				switch key9435807f9d4b97be {
 				case "name":
-					value3229790fc609ef78, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen77f2bf4f0152e5d4[0], 0, "name"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value6fb77970466a5626, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					h.Name = value3229790fc609ef78
+					h.Name = value6fb77970466a5626
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -1899,32 +3226,57 @@ func (h *Host) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj7830d722dfff6d0d--
+					obj4f0a58250d8fb50e--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (l *LargePayload) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := l.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (l *LargePayload) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := l.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (l *LargePayload) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.WriteKey("users")
 	if len(l.Users) == 0 {
 		enc.WriteNull()
 	} else {
 		enc.WriteByte('[')
-		for _, value4ee1609864767f46 := range l.Users {
+		for _, valuefe33408cf9e88e2c := range l.Users {
 			enc.WriteComma()
-			if value4ee1609864767f46 == nil {
+			if valuefe33408cf9e88e2c == nil {
 				enc.WriteNull()
 			} else {
 				enc.WriteByte('{')
-				enc.EncodeKeyString("username", value4ee1609864767f46.Username)
+				enc.EncodeKeyString("username", valuefe33408cf9e88e2c.Username)
 
 				enc.WriteByte('}')
 			}
@@ -1940,15 +3292,15 @@ func (l *LargePayload) MarshalJSON() ([]byte, error) {
 			enc.WriteNull()
 		} else {
 			enc.WriteByte('[')
-			for _, value7124d67d811c8bb5 := range l.Topics.Topics {
+			for _, value797408a32d29416b := range l.Topics.Topics {
 				enc.WriteComma()
-				if value7124d67d811c8bb5 == nil {
+				if value797408a32d29416b == nil {
 					enc.WriteNull()
 				} else {
 					enc.WriteByte('{')
-					enc.EncodeKeyInt("id", value7124d67d811c8bb5.Id)
+					enc.EncodeKeyInt("id", value797408a32d29416b.Id)
 
-					enc.EncodeKeyString("slug", value7124d67d811c8bb5.Slug)
+					enc.EncodeKeyString("slug", value797408a32d29416b.Slug)
 
 					enc.WriteByte('}')
 				}
@@ -1961,15 +3313,36 @@ func (l *LargePayload) MarshalJSON() ([]byte, error) {
 		enc.WriteByte('}')
 	}
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (l *LargePayload) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := l.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (l *LargePayload) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -1980,17 +3353,22 @@ func (l *LargePayload) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for objcd83726d737bad0c := 1; objcd83726d737bad0c > 0; {
-				keyac61e1338d1b65df, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen75e498320982c85a [1]uint64
+			for objaf206a329cfffd4a := 1; objaf206a329cfffd4a > 0; {
+				keyad70384859c05a4b, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch keyac61e1338d1b65df {
+				switch keyad70384859c05a4b {
 				case "users":
+					if dup, err := dec.Seen(&seen75e498320982c85a[0], 0, "users"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if char := dec.NextChar(); char == 'n' {
 						if err := dec.AssetNull(); err != nil {
 							return err
@@ -2008,12 +3386,12 @@ func (l *LargePayload) UnmarshalJSON(data []byte) error {
 								l.Users = make(DSUsers, 0, 8)
 							}
 
-							for arraye6ed344b12d10866 := 1; arraye6ed344b12d10866 > 0; {
-								var valuee456198462641767 *DSUser
+							for array13a1d5b2f5bfef5a := 1; array13a1d5b2f5bfef5a > 0; {
+								var value6ed92da482caa956 *DSUser
 								if dec.IsNull() {
-									valuee456198462641767 = nil
+									value6ed92da482caa956 = nil
 								} else {
-									valuee456198462641767 = new(DSUser)
+									value6ed92da482caa956 = new(DSUser)
 
 									if char := dec.NextChar(); char == 'n' {
 										if err := dec.AssetNull(); err != nil {
@@ -2024,23 +3402,28 @@ func (l *LargePayload) UnmarshalJSON(data []byte) error {
 										return errors.NewParseError(dec.Char(), dec.Cursor())
 									} else {
 										dec.Next()
-										if dec.IsObjectClose() {
-											return nil
-										} else {
-											for obj9e9753f86c972237 := 1; obj9e9753f86c972237 > 0; {
-												key4e18807b5e35d6b1, err := dec.NextKey()
+										if !dec.IsObjectClose() {
+											var seeneb09277b92cef904 [1]uint64
+											for obj8e5b6fe9d8a9ddd9 := 1; obj8e5b6fe9d8a9ddd9 > 0; {
+												key6efa18500944cbe8, err := dec.NextKey()
 												if err != nil {
 													return err
 												}
 
-												switch key4e18807b5e35d6b1 {
+												switch key6efa18500944cbe8 {
 												case "username":
-													valueb8073ce642449475, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seeneb09277b92cef904[0], 0, "username"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value00a0b1527ea64729, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													valuee456198462641767.Username = valueb8073ce642449475
+													value6ed92da482caa956.Username = value00a0b1527ea64729
 
 												default:
 													if err := dec.SkipValue(); err != nil {
@@ -2048,23 +3431,33 @@ func (l *LargePayload) UnmarshalJSON(data []byte) error {
 													}
 												}
 												if dec.IsObjectClose() {
-													obj9e9753f86c972237--
+													obj8e5b6fe9d8a9ddd9--
 												}
 											}
 										}
 									}
 								}
-								if valuee456198462641767 != nil {
-									l.Users = append(l.Users, valuee456198462641767)
+								if value6ed92da482caa956 != nil {
+									l.Users = append(l.Users, value6ed92da482caa956)
+								}
+								if err := dec.CheckElements(len(l.Users)); err != nil {
+									return err
 								}
+
 								if dec.IsArrayClose() {
-									arraye6ed344b12d10866--
+									array13a1d5b2f5bfef5a--
 								}
 							}
 						}
 					}
 
 				case "topics":
+					if dup, err := dec.Seen(&seen75e498320982c85a[0], 1, "topics"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						l.Topics = nil
 					} else if !dec.IsObjectOpen() {
@@ -2077,14 +3470,21 @@ func (l *LargePayload) UnmarshalJSON(data []byte) error {
 								l.Topics = new(DSTopicsList)
 							}
 
-							for obj27e9922923187aac := 1; obj27e9922923187aac > 0; {
-								key7e8142319ac4e978, err := dec.NextKey()
+							var seenc37f4192779ec1d9 [1]uint64
+							for obja861d2f6497a3235 := 1; obja861d2f6497a3235 > 0; {
+								key6b3b1c5424fce0b7, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key7e8142319ac4e978 {
+								switch key6b3b1c5424fce0b7 {
 								case "topics":
+									if dup, err := dec.Seen(&seenc37f4192779ec1d9[0], 0, "topics"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
 									if char := dec.NextChar(); char == 'n' {
 										if err := dec.AssetNull(); err != nil {
 											return err
@@ -2102,12 +3502,12 @@ func (l *LargePayload) UnmarshalJSON(data []byte) error {
 												l.Topics.Topics = make(DSTopics, 0, 8)
 											}
 
-											for array686013877af862b2 := 1; array686013877af862b2 > 0; {
-												var valuee6256ad382cdd7d1 *DSTopic
+											for array1f03abaa40abc944 := 1; array1f03abaa40abc944 > 0; {
+												var value8fddeb2191d945c0 *DSTopic
 												if dec.IsNull() {
-													valuee6256ad382cdd7d1 = nil
+													value8fddeb2191d945c0 = nil
 												} else {
-													valuee6256ad382cdd7d1 = new(DSTopic)
+													value8fddeb2191d945c0 = new(DSTopic)
 
 													if char := dec.NextChar(); char == 'n' {
 														if err := dec.AssetNull(); err != nil {
@@ -2118,31 +3518,42 @@ func (l *LargePayload) UnmarshalJSON(data []byte) error {
 														return errors.NewParseError(dec.Char(), dec.Cursor())
 													} else {
 														dec.Next()
-														if dec.IsObjectClose() {
-															return nil
-														} else {
-															for obj6eb5f730356fe5e0 := 1; obj6eb5f730356fe5e0 > 0; {
-																key052f62a9ba37741d, err := dec.NextKey()
+														if !dec.IsObjectClose() {
+															var seen5d8857b799acb18e [1]uint64
+															for obj4767af847afd0edb := 1; obj4767af847afd0edb > 0; {
+																key4affabe3037ffe7f, err := dec.NextKey()
 																if err != nil {
 																	return err
 																}
 
-																switch key052f62a9ba37741d {
+																switch key4affabe3037ffe7f {
 																case "id":
-																	valueb36901dc5a927782, err := dec.DecodeInt()
+																	if dup, err := dec.Seen(&seen5d8857b799acb18e[0], 0, "id"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valuea68aa8af5e39cc41, err := dec.DecodeInt()
 																	if err != nil {
 																		return err
 																	}
 
-																	valuee6256ad382cdd7d1.Id = valueb36901dc5a927782
+																	value8fddeb2191d945c0.Id = valuea68aa8af5e39cc41
 
 																case "slug":
-																	value8bd7ea0db93e6d1a, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen5d8857b799acb18e[0], 1, "slug"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value6e734d373c5ebebc, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	valuee6256ad382cdd7d1.Slug = value8bd7ea0db93e6d1a
+																	value8fddeb2191d945c0.Slug = value6e734d373c5ebebc
 
 																default:
 																	if err := dec.SkipValue(); err != nil {
@@ -2150,29 +3561,39 @@ func (l *LargePayload) UnmarshalJSON(data []byte) error {
 																	}
 																}
 																if dec.IsObjectClose() {
-																	obj6eb5f730356fe5e0--
+																	obj4767af847afd0edb--
 																}
 															}
 														}
 													}
 												}
-												if valuee6256ad382cdd7d1 != nil {
-													l.Topics.Topics = append(l.Topics.Topics, valuee6256ad382cdd7d1)
+												if value8fddeb2191d945c0 != nil {
+													l.Topics.Topics = append(l.Topics.Topics, value8fddeb2191d945c0)
+												}
+												if err := dec.CheckElements(len(l.Topics.Topics)); err != nil {
+													return err
 												}
+
 												if dec.IsArrayClose() {
-													array686013877af862b2--
+													array1f03abaa40abc944--
 												}
 											}
 										}
 									}
 
 								case "more_topics_url":
-									value66e8b0c894da962a, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seenc37f4192779ec1d9[0], 1, "more_topics_url"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value9cdcc595bcce3c7b, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									l.Topics.MoreTopicsUrl = value66e8b0c894da962a
+									l.Topics.MoreTopicsUrl = value9cdcc595bcce3c7b
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -2180,7 +3601,7 @@ func (l *LargePayload) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj27e9922923187aac--
+									obja861d2f6497a3235--
 								}
 							}
 						}
@@ -2192,19 +3613,44 @@ func (l *LargePayload) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					objcd83726d737bad0c--
+					objaf206a329cfffd4a--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (m *MediumPayload) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := m.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
 
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (m *MediumPayload) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := m.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
+
+func (m *MediumPayload) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	if m.Person != nil {
 		enc.WriteKey("person")
@@ -2231,13 +3677,13 @@ func (m *MediumPayload) MarshalJSON() ([]byte, error) {
 				enc.WriteNull()
 			} else {
 				enc.WriteByte('[')
-				for _, value2addc6d33dbcb6c8 := range m.Person.Gravatar.Avatars {
+				for _, valued3d8df93fab7e125 := range m.Person.Gravatar.Avatars {
 					enc.WriteComma()
-					if value2addc6d33dbcb6c8 == nil {
+					if valued3d8df93fab7e125 == nil {
 						enc.WriteNull()
 					} else {
 						enc.WriteByte('{')
-						enc.EncodeKeyString("url", value2addc6d33dbcb6c8.Url)
+						enc.EncodeKeyString("url", valued3d8df93fab7e125.Url)
 
 						enc.WriteByte('}')
 					}
@@ -2254,15 +3700,36 @@ func (m *MediumPayload) MarshalJSON() ([]byte, error) {
 	}
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (m *MediumPayload) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := m.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (m *MediumPayload) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -2273,17 +3740,22 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj54ad5ff63b907db2 := 1; obj54ad5ff63b907db2 > 0; {
-				key1fd1cefcd0bd15ff, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen41e2d2ce9c2b1789 [1]uint64
+			for objddebafe65a31bd5d := 1; objddebafe65a31bd5d > 0; {
+				key2f0fea1931a29022, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key1fd1cefcd0bd15ff {
+				switch key2f0fea1931a29022 {
 				case "person":
+					if dup, err := dec.Seen(&seen41e2d2ce9c2b1789[0], 0, "person"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						m.Person = nil
 					} else if !dec.IsObjectOpen() {
@@ -2296,14 +3768,21 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 								m.Person = new(CBPerson)
 							}
 
-							for objadf8fcccba0f2da0 := 1; objadf8fcccba0f2da0 > 0; {
-								key266e38ba5f5e4283, err := dec.NextKey()
+							var seena68406e877073ff0 [1]uint64
+							for obj0777a93143dfdcbf := 1; obj0777a93143dfdcbf > 0; {
+								key8834e197a4034aa4, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key266e38ba5f5e4283 {
+								switch key8834e197a4034aa4 {
 								case "name":
+									if dup, err := dec.Seen(&seena68406e877073ff0[0], 0, "name"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
 									if dec.IsNull() {
 										m.Person.Name = nil
 									} else if !dec.IsObjectOpen() {
@@ -2316,20 +3795,27 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 												m.Person.Name = new(CBName)
 											}
 
-											for objb4d73940f39e5172 := 1; objb4d73940f39e5172 > 0; {
-												keya8d3edb3565cbcad, err := dec.NextKey()
+											var seen93da538101644021 [1]uint64
+											for objcaebbac880b5b89b := 1; objcaebbac880b5b89b > 0; {
+												key04e648b6226a1b78, err := dec.NextKey()
 												if err != nil {
 													return err
 												}
 
-												switch keya8d3edb3565cbcad {
+												switch key04e648b6226a1b78 {
 												case "fullName":
-													value0188e44c00250db4, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seen93da538101644021[0], 0, "fullName"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value3a89ddfc454c5f8f, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													m.Person.Name.FullName = value0188e44c00250db4
+													m.Person.Name.FullName = value3a89ddfc454c5f8f
 
 												default:
 													if err := dec.SkipValue(); err != nil {
@@ -2337,13 +3823,19 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 													}
 												}
 												if dec.IsObjectClose() {
-													objb4d73940f39e5172--
+													objcaebbac880b5b89b--
 												}
 											}
 										}
 									}
 
 								case "github":
+									if dup, err := dec.Seen(&seena68406e877073ff0[0], 1, "github"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
 									if dec.IsNull() {
 										m.Person.Github = nil
 									} else if !dec.IsObjectOpen() {
@@ -2356,20 +3848,27 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 												m.Person.Github = new(CBGithub)
 											}
 
-											for objad4cad38378201e5 := 1; objad4cad38378201e5 > 0; {
-												key65d6a6d301f7b6ea, err := dec.NextKey()
+											var seen84c19e9beac03c87 [1]uint64
+											for obj72ac89b38b19f537 := 1; obj72ac89b38b19f537 > 0; {
+												key5a27db029de37ae3, err := dec.NextKey()
 												if err != nil {
 													return err
 												}
 
-												switch key65d6a6d301f7b6ea {
+												switch key5a27db029de37ae3 {
 												case "followers":
-													valuec4f8fc6f97f0ab63, err := dec.DecodeInt()
+													if dup, err := dec.Seen(&seen84c19e9beac03c87[0], 0, "followers"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value929359ca8c5eb94e, err := dec.DecodeInt()
 													if err != nil {
 														return err
 													}
 
-													m.Person.Github.Followers = valuec4f8fc6f97f0ab63
+													m.Person.Github.Followers = value929359ca8c5eb94e
 
 												default:
 													if err := dec.SkipValue(); err != nil {
@@ -2377,13 +3876,19 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 													}
 												}
 												if dec.IsObjectClose() {
-													objad4cad38378201e5--
+													obj72ac89b38b19f537--
 												}
 											}
 										}
 									}
 
 								case "gravatar":
+									if dup, err := dec.Seen(&seena68406e877073ff0[0], 2, "gravatar"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
 									if dec.IsNull() {
 										m.Person.Gravatar = nil
 									} else if !dec.IsObjectOpen() {
@@ -2396,14 +3901,21 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 												m.Person.Gravatar = new(CBGravatar)
 											}
 
-											for obj499300b6ed2f7d8d := 1; obj499300b6ed2f7d8d > 0; {
-												keyd8acb8bf1e81377e, err := dec.NextKey()
+											var seen76c1bdd19ab8e292 [1]uint64
+											for obj152dc1af42ea3d16 := 1; obj152dc1af42ea3d16 > 0; {
+												key5c6daee4de5ef9f9, err := dec.NextKey()
 												if err != nil {
 													return err
 												}
 
-												switch keyd8acb8bf1e81377e {
+												switch key5c6daee4de5ef9f9 {
 												case "avatars":
+													if dup, err := dec.Seen(&seen76c1bdd19ab8e292[0], 0, "avatars"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
 													if char := dec.NextChar(); char == 'n' {
 														if err := dec.AssetNull(); err != nil {
 															return err
@@ -2421,12 +3933,12 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 																m.Person.Gravatar.Avatars = make(Avatars, 0, 8)
 															}
 
-															for array04043ee4d8a70e31 := 1; array04043ee4d8a70e31 > 0; {
-																var valuee99b30eb5c17dbaa *CBAvatar
+															for array09398585928a0f7d := 1; array09398585928a0f7d > 0; {
+																var valuee50be1a6dc1d5768 *CBAvatar
 																if dec.IsNull() {
-																	valuee99b30eb5c17dbaa = nil
+																	valuee50be1a6dc1d5768 = nil
 																} else {
-																	valuee99b30eb5c17dbaa = new(CBAvatar)
+																	valuee50be1a6dc1d5768 = new(CBAvatar)
 
 																	if char := dec.NextChar(); char == 'n' {
 																		if err := dec.AssetNull(); err != nil {
@@ -2437,23 +3949,28 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 																		return errors.NewParseError(dec.Char(), dec.Cursor())
 																	} else {
 																		dec.Next()
-																		if dec.IsObjectClose() {
-																			return nil
-																		} else {
-																			for objbf8a1ccafc894746 := 1; objbf8a1ccafc894746 > 0; {
-																				keyc69b6f34a46571f3, err := dec.NextKey()
+																		if !dec.IsObjectClose() {
+																			var seene9b948c918bba3e9 [1]uint64
+																			for obje8537988fddce562 := 1; obje8537988fddce562 > 0; {
+																				key33e5c400cde5e60c, err := dec.NextKey()
 																				if err != nil {
 																					return err
 																				}
 
-																				switch keyc69b6f34a46571f3 {
+																				switch key33e5c400cde5e60c {
 																				case "url":
-																					valuebd1848e8bf832e56, err := dec.DecodeString()
+																					if dup, err := dec.Seen(&seene9b948c918bba3e9[0], 0, "url"); err != nil {
+																						return err
+																					} else if dup {
+																						break
+																					}
+
+																					value5ead6fc7ae77ba1d, err := dec.DecodeString()
 																					if err != nil {
 																						return err
 																					}
 
-																					valuee99b30eb5c17dbaa.Url = valuebd1848e8bf832e56
+																					valuee50be1a6dc1d5768.Url = value5ead6fc7ae77ba1d
 
 																				default:
 																					if err := dec.SkipValue(); err != nil {
@@ -2461,17 +3978,21 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 																					}
 																				}
 																				if dec.IsObjectClose() {
-																					objbf8a1ccafc894746--
+																					obje8537988fddce562--
 																				}
 																			}
 																		}
 																	}
 																}
-																if valuee99b30eb5c17dbaa != nil {
-																	m.Person.Gravatar.Avatars = append(m.Person.Gravatar.Avatars, valuee99b30eb5c17dbaa)
+																if valuee50be1a6dc1d5768 != nil {
+																	m.Person.Gravatar.Avatars = append(m.Person.Gravatar.Avatars, valuee50be1a6dc1d5768)
+																}
+																if err := dec.CheckElements(len(m.Person.Gravatar.Avatars)); err != nil {
+																	return err
 																}
+
 																if dec.IsArrayClose() {
-																	array04043ee4d8a70e31--
+																	array09398585928a0f7d--
 																}
 															}
 														}
@@ -2483,7 +4004,7 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 													}
 												}
 												if dec.IsObjectClose() {
-													obj499300b6ed2f7d8d--
+													obj152dc1af42ea3d16--
 												}
 											}
 										}
@@ -2495,19 +4016,25 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									objadf8fcccba0f2da0--
+									obj0777a93143dfdcbf--
 								}
 							}
 						}
 					}
 
 				case "company":
-					value6400658db665f0d7, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen41e2d2ce9c2b1789[0], 1, "company"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value259b188a4b21c86f, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					m.Company = value6400658db665f0d7
+					m.Company = value259b188a4b21c86f
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -2515,19 +4042,44 @@ func (m *MediumPayload) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj54ad5ff63b907db2--
+					objddebafe65a31bd5d--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (m *Metadata) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := m.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
 
+func (m *Metadata) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := m.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
+
+func (m *Metadata) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("beat", m.Beat)
 
@@ -2538,15 +4090,36 @@ func (m *Metadata) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyString("topic", m.Topic)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (m *Metadata) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := m.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (m *Metadata) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -2557,47 +4130,70 @@ func (m *Metadata) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj5bbe552a88508b01 := 1; obj5bbe552a88508b01 > 0; {
-				keydc633fa2171eb00d, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seenda650af24c56d080 [1]uint64
+			for objbc23d728b45347ea := 1; objbc23d728b45347ea > 0; {
+				key0a8691332088a805, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch keydc633fa2171eb00d {
+				switch key0a8691332088a805 {
 				case "beat":
-					value16ac6c2518dd5f0f, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenda650af24c56d080[0], 0, "beat"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuebd55c446e25eb075, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					m.Beat = value16ac6c2518dd5f0f
+					m.Beat = valuebd55c446e25eb075
 
 				case "type":
-					value7411f4e88c09bad0, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenda650af24c56d080[0], 1, "type"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value90bafcccbec61775, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					m.Type = value7411f4e88c09bad0
+					m.Type = value90bafcccbec61775
 
 				case "version":
-					value384c627c3a782886, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenda650af24c56d080[0], 2, "version"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value36401d9a2b7f512b, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					m.Version = value384c627c3a782886
+					m.Version = value36401d9a2b7f512b
 
 				case "topic":
-					valueb79a4216944b0e08, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenda650af24c56d080[0], 3, "topic"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value54bfc9d00532adf5, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					m.Topic = valueb79a4216944b0e08
+					m.Topic = value54bfc9d00532adf5
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -2605,19 +4201,44 @@ func (m *Metadata) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj5bbe552a88508b01--
+					objbc23d728b45347ea--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
-func (n *Network) MarshalJSON() ([]byte, error) {
-	enc := backend.NewEncoder()
+func (n *Network) MarshalJSON() ([]byte, error) {
+	enc := backend.NewEncoder()
+	if err := n.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (n *Network) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := n.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (n *Network) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("type", n.Type)
 
@@ -2630,15 +4251,36 @@ func (n *Network) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyInt("bytes", n.Bytes)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (n *Network) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := n.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (n *Network) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -2649,55 +4291,84 @@ func (n *Network) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for objd19825e5dd803528 := 1; objd19825e5dd803528 > 0; {
-				keybdb12896f89e7bdf, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen9f77d9042c5bce26 [1]uint64
+			for objaaa7c3a96bc59b48 := 1; objaaa7c3a96bc59b48 > 0; {
+				keyb163defde5ee6a0f, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch keybdb12896f89e7bdf {
+				switch keyb163defde5ee6a0f {
 				case "type":
-					value3d23e749f3fa97cc, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen9f77d9042c5bce26[0], 0, "type"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuebb3e9346cef81f0a, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					n.Type = value3d23e749f3fa97cc
+					n.Type = valuebb3e9346cef81f0a
 
 				case "transport":
-					valuec310cba46e2bdba3, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen9f77d9042c5bce26[0], 1, "transport"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuee9515ef30fa47a36, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					n.Transport = valuec310cba46e2bdba3
+					n.Transport = valuee9515ef30fa47a36
 
 				case "protocol":
-					valueba1e8eda64e85bd3, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen9f77d9042c5bce26[0], 2, "protocol"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value4e75aea9e111d596, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					n.Protocol = valueba1e8eda64e85bd3
+					n.Protocol = value4e75aea9e111d596
 
 				case "community_id":
-					value2dcc6f8399fda676, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen9f77d9042c5bce26[0], 3, "community_id"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuee685a591121966e0, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					n.CommunityID = value2dcc6f8399fda676
+					n.CommunityID = valuee685a591121966e0
 
 				case "bytes":
-					valueb72244925263e7e1, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen9f77d9042c5bce26[0], 4, "bytes"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value31650d510354aa84, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					n.Bytes = valueb72244925263e7e1
+					n.Bytes = value31650d510354aa84
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -2705,19 +4376,44 @@ func (n *Network) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					objd19825e5dd803528--
+					objaaa7c3a96bc59b48--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (r *Request) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := r.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (r *Request) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := r.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (r *Request) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("referrer", r.Referrer)
 
@@ -2754,16 +4450,44 @@ func (r *Request) MarshalJSON() ([]byte, error) {
 	enc.WriteByte('}')
 	enc.EncodeKeyString("method", r.Method)
 
+	enc.WriteKey("body")
+	enc.WriteByte('{')
+	enc.EncodeKeyString("content", r.Body.Content)
+
+	enc.EncodeKeyInt("bytes", r.Body.Bytes)
+
+	enc.WriteByte('}')
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (r *Request) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := r.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (r *Request) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -2774,33 +4498,50 @@ func (r *Request) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj1fefe3f2420c906b := 1; obj1fefe3f2420c906b > 0; {
-				key5001b37b8ed2c789, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen514ca197c875f1d0 [1]uint64
+			for obj5580ff560760fd36 := 1; obj5580ff560760fd36 > 0; {
+				key2d9216eba7627e23, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key5001b37b8ed2c789 {
+				switch key2d9216eba7627e23 {
 				case "referrer":
-					valuea7b01c93c45ce72b, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen514ca197c875f1d0[0], 0, "referrer"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value98322eb5cf43d72b, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.Referrer = valuea7b01c93c45ce72b
+					r.Referrer = value98322eb5cf43d72b
 
 				case "bytes":
-					valueb59e23b075d3f903, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen514ca197c875f1d0[0], 1, "bytes"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valued2e5b887d4630fb8, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					r.Bytes = valueb59e23b075d3f903
+					r.Bytes = valued2e5b887d4630fb8
 
 				case "headers":
+					if dup, err := dec.Seen(&seen514ca197c875f1d0[0], 2, "headers"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						r.Headers = RequestHeaders{}
 					} else if !dec.IsObjectOpen() {
@@ -2809,116 +4550,195 @@ func (r *Request) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							r.Headers = RequestHeaders{}
 						} else {
-							for obje554f9ac5692a215 := 1; obje554f9ac5692a215 > 0; {
-								key08f8b5ca77c2c152, err := dec.NextKey()
+							var seen1c5b078143ee26a5 [1]uint64
+							for objd4747ead6eb82acd := 1; objd4747ead6eb82acd > 0; {
+								key86ad23139d504172, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key08f8b5ca77c2c152 {
+								switch key86ad23139d504172 {
 								case "referer":
-									valueda44dce36dead6a2, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 0, "referer"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value9123461c41f5ff99, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.Referer = valueda44dce36dead6a2
+									r.Headers.Referer = value9123461c41f5ff99
 
 								case "x-requested-with":
-									value8231578bb1c713c9, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 1, "x-requested-with"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valueaa99ce24eb4d7885, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.XRequestedWith = value8231578bb1c713c9
+									r.Headers.XRequestedWith = valueaa99ce24eb4d7885
 
 								case "yz_client_ip":
-									value944e8461d511a645, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 2, "yz_client_ip"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value76e3336e65491622, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.YzClientIP = value944e8461d511a645
+									r.Headers.YzClientIP = value76e3336e65491622
 
 								case "user-agent":
-									value051ea4825d6fbaec, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 3, "user-agent"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value558fdf297b9fa007, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.UserAgent = value051ea4825d6fbaec
+									r.Headers.UserAgent = value558fdf297b9fa007
 
 								case "accept-language":
-									value3cec31b35970155a, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 4, "accept-language"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value864bafd7cd4ca1b2, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.AcceptLanguage = value3cec31b35970155a
+									r.Headers.AcceptLanguage = value864bafd7cd4ca1b2
 
 								case "content-length":
-									valuee3baa18a2cb71400, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 5, "content-length"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuefb5766ab431a032b, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.ContentLength = valuee3baa18a2cb71400
+									r.Headers.ContentLength = valuefb5766ab431a032b
 
 								case "x-real-ip":
-									value45c1ba632d211d92, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 6, "x-real-ip"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value72b9a7e937ed648d, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.XRealIP = value45c1ba632d211d92
+									r.Headers.XRealIP = value72b9a7e937ed648d
 
 								case "pragma":
-									value8c68ae8544e531de, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 7, "pragma"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value0801f29055d3090d, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.Pragma = value8c68ae8544e531de
+									r.Headers.Pragma = value0801f29055d3090d
 
 								case "connection":
-									value2cd9cc94cc67bf7f, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 8, "connection"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value2463718254f94424, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.Connection = value2cd9cc94cc67bf7f
+									r.Headers.Connection = value2463718254f94424
 
 								case "accept":
-									value203bacd3a77ce219, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 9, "accept"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value83c7b98b938045da, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.Accept = value203bacd3a77ce219
+									r.Headers.Accept = value83c7b98b938045da
 
 								case "host":
-									value31ff23ab5653f8ed, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 10, "host"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value519843854b0ed3f7, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.Host = value31ff23ab5653f8ed
+									r.Headers.Host = value519843854b0ed3f7
 
 								case "x-forwarded-for":
-									value9d8b167c805bb48a, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 11, "x-forwarded-for"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valueba951a493f321f09, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.XForwardedFor = value9d8b167c805bb48a
+									r.Headers.XForwardedFor = valueba951a493f321f09
 
 								case "content-type":
-									valueb146b0ec418e47f8, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen1c5b078143ee26a5[0], 12, "content-type"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value66603022c1dfc579, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.ContentType = valueb146b0ec418e47f8
+									r.Headers.ContentType = value66603022c1dfc579
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -2926,109 +4746,133 @@ func (r *Request) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obje554f9ac5692a215--
+									objd4747ead6eb82acd--
 								}
 							}
 						}
 					}
 
 				case "method":
-					valuea49dc0682f2c2cb8, err := dec.DecodeString()
-					if err != nil {
+					if dup, err := dec.Seen(&seen514ca197c875f1d0[0], 3, "method"); err != nil {
 						return err
+					} else if dup {
+						break
 					}
 
-					r.Method = valuea49dc0682f2c2cb8
-
-				default:
-					if err := dec.SkipValue(); err != nil {
+					valueb99ed9d20d573ad5, err := dec.DecodeString()
+					if err != nil {
 						return err
 					}
-				}
-				if dec.IsObjectClose() {
-					obj1fefe3f2420c906b--
-				}
-			}
-		}
-	}
-	dec.Release()
-
-	return nil
-}
 
-func (r *Body) MarshalJSON() ([]byte, error) {
-	enc := backend.NewEncoder()
-
-	enc.WriteByte('{')
-	enc.EncodeKeyString("content", r.Content)
+					r.Method = valueb99ed9d20d573ad5
 
-	enc.EncodeKeyInt("bytes", r.Bytes)
+				case "body":
+					if dup, err := dec.Seen(&seen514ca197c875f1d0[0], 4, "body"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
 
-	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
+					if dec.IsNull() {
+						r.Body = Body{}
+					} else if !dec.IsObjectOpen() {
+						return errors.NewParseError(dec.Char(), dec.Cursor())
+					} else {
+						if dec.IsObjectClose() {
+							r.Body = Body{}
+						} else {
+							var seen613bb365b2ebb44f [1]uint64
+							for obj3171c8fef7f1f4e4 := 1; obj3171c8fef7f1f4e4 > 0; {
+								key0ffb6907136385cd, err := dec.NextKey()
+								if err != nil {
+									return err
+								}
 
-	return data, nil
-}
+								switch key0ffb6907136385cd {
+								case "content":
+									if dup, err := dec.Seen(&seen613bb365b2ebb44f[0], 0, "content"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
 
-func (r *Body) UnmarshalJSON(data []byte) error {
-	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+									value42577410aca008c2, err := dec.DecodeString()
+									if err != nil {
+										return err
+									}
 
-	if char := dec.NextChar(); char == 'n' {
-		if err := dec.AssetNull(); err != nil {
-			return err
-		}
+									r.Body.Content = value42577410aca008c2
 
-	} else if char != '{' {
-		return errors.NewParseError(dec.Char(), dec.Cursor())
-	} else {
-		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj58e67b4236188334 := 1; obj58e67b4236188334 > 0; {
-				key01659397c699bfb3, err := dec.NextKey()
-				if err != nil {
-					return err
-				}
+								case "bytes":
+									if dup, err := dec.Seen(&seen613bb365b2ebb44f[0], 1, "bytes"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
 
-				switch key01659397c699bfb3 {
-				case "content":
-					value5babcbe9858e9763, err := dec.DecodeString()
-					if err != nil {
-						return err
-					}
+									valueafbc4c79c62572e2, err := dec.DecodeInt()
+									if err != nil {
+										return err
+									}
 
-					r.Content = value5babcbe9858e9763
+									r.Body.Bytes = valueafbc4c79c62572e2
 
-				case "bytes":
-					value8ff708974ce0a31c, err := dec.DecodeInt()
-					if err != nil {
-						return err
+								default:
+									if err := dec.SkipValue(); err != nil {
+										return err
+									}
+								}
+								if dec.IsObjectClose() {
+									obj3171c8fef7f1f4e4--
+								}
+							}
+						}
 					}
 
-					r.Bytes = value8ff708974ce0a31c
-
 				default:
 					if err := dec.SkipValue(); err != nil {
 						return err
 					}
 				}
 				if dec.IsObjectClose() {
-					obj58e67b4236188334--
+					obj5580ff560760fd36--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (r *RequestHeaders) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := r.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (r *RequestHeaders) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := r.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
 
+	return dst, nil
+}
+
+func (r *RequestHeaders) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("referer", r.Referer)
 
@@ -3057,15 +4901,36 @@ func (r *RequestHeaders) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyString("content-type", r.ContentType)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (r *RequestHeaders) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := r.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (r *RequestHeaders) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -3076,119 +4941,196 @@ func (r *RequestHeaders) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for objff5daad3bb5648a1 := 1; objff5daad3bb5648a1 > 0; {
-				keyc31e6327e1efcfa6, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seenaa1cc84c887e1f7c [1]uint64
+			for obj0f8ed94ee62b4de7 := 1; obj0f8ed94ee62b4de7 > 0; {
+				key31e927dfe52a5f8f, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch keyc31e6327e1efcfa6 {
+				switch key31e927dfe52a5f8f {
 				case "referer":
-					valuedeffac244ab15b06, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 0, "referer"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value46627eb5d3a4fe16, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.Referer = valuedeffac244ab15b06
+					r.Referer = value46627eb5d3a4fe16
 
 				case "x-requested-with":
-					valuef65136b8fea693a5, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 1, "x-requested-with"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuefafce23623e196c9, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.XRequestedWith = valuef65136b8fea693a5
+					r.XRequestedWith = valuefafce23623e196c9
 
 				case "yz_client_ip":
-					valueb94c3b571e3d4903, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 2, "yz_client_ip"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuedfff7fbaff4ffe94, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.YzClientIP = valueb94c3b571e3d4903
+					r.YzClientIP = valuedfff7fbaff4ffe94
 
 				case "user-agent":
-					valuefb8161030126662a, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 3, "user-agent"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuef4589733e563e19d, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.UserAgent = valuefb8161030126662a
+					r.UserAgent = valuef4589733e563e19d
 
 				case "accept-language":
-					valueb193e19010c87c6d, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 4, "accept-language"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value3045aad3e226488a, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.AcceptLanguage = valueb193e19010c87c6d
+					r.AcceptLanguage = value3045aad3e226488a
 
 				case "content-length":
-					valuebf07bda1318ae44d, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 5, "content-length"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuec02cca4291aed169, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					r.ContentLength = valuebf07bda1318ae44d
+					r.ContentLength = valuec02cca4291aed169
 
 				case "x-real-ip":
-					value39f285a0163eb434, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 6, "x-real-ip"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuedce5039d6ab00e40, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.XRealIP = value39f285a0163eb434
+					r.XRealIP = valuedce5039d6ab00e40
 
 				case "pragma":
-					value1d53e77749bbaab6, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 7, "pragma"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuef67aab29332de144, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.Pragma = value1d53e77749bbaab6
+					r.Pragma = valuef67aab29332de144
 
 				case "connection":
-					value8ddf096617b84083, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 8, "connection"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value8b35507c7c8a09c4, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.Connection = value8ddf096617b84083
+					r.Connection = value8b35507c7c8a09c4
+
+				case "accept":
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 9, "accept"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
 
-				case "accept":
-					value24add669cbd13d8c, err := dec.DecodeString()
+					valuedb07105dc3100362, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.Accept = value24add669cbd13d8c
+					r.Accept = valuedb07105dc3100362
 
 				case "host":
-					value242d094499b1b6a1, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 10, "host"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value0405da3b2169f5a9, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.Host = value242d094499b1b6a1
+					r.Host = value0405da3b2169f5a9
 
 				case "x-forwarded-for":
-					value2ac3b92d2e40659d, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 11, "x-forwarded-for"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value10c9d0096e5e3ef1, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.XForwardedFor = value2ac3b92d2e40659d
+					r.XForwardedFor = value10c9d0096e5e3ef1
 
 				case "content-type":
-					value7a937975fcf943c2, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seenaa1cc84c887e1f7c[0], 12, "content-type"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valueb570680746acd0cc, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.ContentType = value7a937975fcf943c2
+					r.ContentType = valueb570680746acd0cc
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -3196,19 +5138,44 @@ func (r *RequestHeaders) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					objff5daad3bb5648a1--
+					obj0f8ed94ee62b4de7--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (r *Response) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := r.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (r *Response) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := r.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (r *Response) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyInt("status_code", r.StatusCode)
 
@@ -3241,15 +5208,36 @@ func (r *Response) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyString("status_phrase", r.StatusPhrase)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (r *Response) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := r.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (r *Response) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -3260,25 +5248,36 @@ func (r *Response) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj57e5aba30f4da17a := 1; obj57e5aba30f4da17a > 0; {
-				keya06e5bcfcf45432b, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seend342b051b5df4106 [1]uint64
+			for obj7760331b663138d6 := 1; obj7760331b663138d6 > 0; {
+				key37cf7aee9b0c8c10, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch keya06e5bcfcf45432b {
+				switch key37cf7aee9b0c8c10 {
 				case "status_code":
-					valueb49ac41ddb65a379, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seend342b051b5df4106[0], 0, "status_code"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuea8f9980630f34ce0, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					r.StatusCode = valueb49ac41ddb65a379
+					r.StatusCode = valuea8f9980630f34ce0
 
 				case "body":
+					if dup, err := dec.Seen(&seend342b051b5df4106[0], 1, "body"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						r.Body = Body{}
 					} else if !dec.IsObjectOpen() {
@@ -3287,28 +5286,41 @@ func (r *Response) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							r.Body = Body{}
 						} else {
-							for objcca50dde7ebdcee9 := 1; objcca50dde7ebdcee9 > 0; {
-								key71d3ddceed0e0003, err := dec.NextKey()
+							var seen39b216cbc50e73a3 [1]uint64
+							for obj01c0ab7ac65e502d := 1; obj01c0ab7ac65e502d > 0; {
+								key2eaf936401e2506b, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key71d3ddceed0e0003 {
+								switch key2eaf936401e2506b {
 								case "content":
-									value82d8c53c78f499ea, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen39b216cbc50e73a3[0], 0, "content"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value2fa319f245a8657e, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Body.Content = value82d8c53c78f499ea
+									r.Body.Content = value2fa319f245a8657e
 
 								case "bytes":
-									value1c84e71bc39ae81f, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seen39b216cbc50e73a3[0], 1, "bytes"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuec122eaf4ad5425c2, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									r.Body.Bytes = value1c84e71bc39ae81f
+									r.Body.Bytes = valuec122eaf4ad5425c2
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -3316,21 +5328,33 @@ func (r *Response) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									objcca50dde7ebdcee9--
+									obj01c0ab7ac65e502d--
 								}
 							}
 						}
 					}
 
 				case "bytes":
-					valuec58ccaffbad61fc2, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seend342b051b5df4106[0], 2, "bytes"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value49ee160e17b95541, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					r.Bytes = valuec58ccaffbad61fc2
+					r.Bytes = value49ee160e17b95541
 
 				case "headers":
+					if dup, err := dec.Seen(&seend342b051b5df4106[0], 3, "headers"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						r.Headers = ResponseHeaders{}
 					} else if !dec.IsObjectOpen() {
@@ -3339,68 +5363,111 @@ func (r *Response) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							r.Headers = ResponseHeaders{}
 						} else {
-							for obj9827d4053e24b47b := 1; obj9827d4053e24b47b > 0; {
-								key245cdec07ecd46b9, err := dec.NextKey()
+							var seene3f8e784870fd87a [1]uint64
+							for objc2aee5df820ac85d := 1; objc2aee5df820ac85d > 0; {
+								key36cc0d163833df63, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key245cdec07ecd46b9 {
+								switch key36cc0d163833df63 {
 								case "content-length":
-									value46a28e8bb1b80abc, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seene3f8e784870fd87a[0], 0, "content-length"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value592835b9f6f4f8c0, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.ContentLength = value46a28e8bb1b80abc
+									r.Headers.ContentLength = value592835b9f6f4f8c0
 
 								case "transfer-encoding":
-									value90603c2d7bbc50dc, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seene3f8e784870fd87a[0], 1, "transfer-encoding"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuee70dbeebae7b14cd, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.TransferEncoding = value90603c2d7bbc50dc
+									r.Headers.TransferEncoding = valuee70dbeebae7b14cd
 
 								case "connection":
-									value5b291a7914b3fa2e, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seene3f8e784870fd87a[0], 2, "connection"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valueb9bc41033aa5baf4, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.Connection = value5b291a7914b3fa2e
+									r.Headers.Connection = valueb9bc41033aa5baf4
 
 								case "cache-control":
-									valuee4e7290ae0c16041, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seene3f8e784870fd87a[0], 3, "cache-control"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value0d45e24d72eac4a2, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.CacheControl = valuee4e7290ae0c16041
+									r.Headers.CacheControl = value0d45e24d72eac4a2
 
 								case "pragma":
-									value5506e27b7088e5e8, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seene3f8e784870fd87a[0], 4, "pragma"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value8e3ca030c9937ab8, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.Pragma = value5506e27b7088e5e8
+									r.Headers.Pragma = value8e3ca030c9937ab8
 
 								case "server":
-									value9c472e40d4b1ca2d, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seene3f8e784870fd87a[0], 5, "server"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value409a7cbf05ae21f9, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.Server = value9c472e40d4b1ca2d
+									r.Headers.Server = value409a7cbf05ae21f9
 
 								case "date":
-									valueb5cc13a67771276b, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seene3f8e784870fd87a[0], 6, "date"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value7425254543d94d11, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									r.Headers.Date = valueb5cc13a67771276b
+									r.Headers.Date = value7425254543d94d11
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -3408,19 +5475,25 @@ func (r *Response) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj9827d4053e24b47b--
+									objc2aee5df820ac85d--
 								}
 							}
 						}
 					}
 
 				case "status_phrase":
-					value44f5253676a23261, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seend342b051b5df4106[0], 4, "status_phrase"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value5900b90ae703b97d, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.StatusPhrase = value44f5253676a23261
+					r.StatusPhrase = value5900b90ae703b97d
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -3428,19 +5501,44 @@ func (r *Response) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj57e5aba30f4da17a--
+					obj7760331b663138d6--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (r *ResponseHeaders) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := r.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (r *ResponseHeaders) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := r.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (r *ResponseHeaders) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyInt("content-length", r.ContentLength)
 
@@ -3457,15 +5555,36 @@ func (r *ResponseHeaders) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyString("date", r.Date)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (r *ResponseHeaders) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := r.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (r *ResponseHeaders) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -3476,71 +5595,112 @@ func (r *ResponseHeaders) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj38b7180c2947a958 := 1; obj38b7180c2947a958 > 0; {
-				key212a8db6e16e1543, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seena677de8b18cb454b [1]uint64
+			for obj9856d2441d14ba49 := 1; obj9856d2441d14ba49 > 0; {
+				key99ddd9daa7ccbb75, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key212a8db6e16e1543 {
+				switch key99ddd9daa7ccbb75 {
 				case "content-length":
-					valuec2ffc341a9a964b8, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seena677de8b18cb454b[0], 0, "content-length"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value00dae4e2e5df8cf3, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					r.ContentLength = valuec2ffc341a9a964b8
+					r.ContentLength = value00dae4e2e5df8cf3
 
 				case "transfer-encoding":
-					valuec1ac1c547a946f43, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seena677de8b18cb454b[0], 1, "transfer-encoding"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value859ebddada6745fb, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.TransferEncoding = valuec1ac1c547a946f43
+					r.TransferEncoding = value859ebddada6745fb
 
 				case "connection":
-					value5e3a1d372762eb13, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seena677de8b18cb454b[0], 2, "connection"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuea6a04c5c37c7ca35, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.Connection = value5e3a1d372762eb13
+					r.Connection = valuea6a04c5c37c7ca35
 
 				case "cache-control":
-					value538f131f8857d693, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seena677de8b18cb454b[0], 3, "cache-control"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value036f11732ce8bc27, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.CacheControl = value538f131f8857d693
+					r.CacheControl = value036f11732ce8bc27
 
 				case "pragma":
-					value8c736701cd3ff003, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seena677de8b18cb454b[0], 4, "pragma"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valueb48868611fc73c82, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.Pragma = value8c736701cd3ff003
+					r.Pragma = valueb48868611fc73c82
 
 				case "server":
-					value1f3b1cb86aca31f2, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seena677de8b18cb454b[0], 5, "server"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuea491bfabd7a19df5, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.Server = value1f3b1cb86aca31f2
+					r.Server = valuea491bfabd7a19df5
 
 				case "date":
-					valuee951c9571c2abb61, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seena677de8b18cb454b[0], 6, "date"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value0fdc78a55dbbc2fd, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					r.Date = valuee951c9571c2abb61
+					r.Date = value0fdc78a55dbbc2fd
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -3548,19 +5708,44 @@ func (r *ResponseHeaders) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj38b7180c2947a958--
+					obj9856d2441d14ba49--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (s *Server) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := s.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (s *Server) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := s.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (s *Server) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("ip", s.IP)
 
@@ -3571,15 +5756,36 @@ func (s *Server) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyInt("bytes", s.Bytes)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (s *Server) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := s.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (s *Server) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -3590,47 +5796,70 @@ func (s *Server) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj03cca33e8082d5f8 := 1; obj03cca33e8082d5f8 > 0; {
-				keycf310fba34049853, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen885b039f30e706f0 [1]uint64
+			for obj37f9296566557fab := 1; obj37f9296566557fab > 0; {
+				keycd5961e19b642221, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch keycf310fba34049853 {
+				switch keycd5961e19b642221 {
 				case "ip":
-					valueeaed2bcbf113af4a, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen885b039f30e706f0[0], 0, "ip"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuedb44a69497b8ad99, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					s.IP = valueeaed2bcbf113af4a
+					s.IP = valuedb44a69497b8ad99
 
 				case "port":
-					valuec3b172d3fe4a9df1, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen885b039f30e706f0[0], 1, "port"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value408fe1e037c68bf7, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					s.Port = valuec3b172d3fe4a9df1
+					s.Port = value408fe1e037c68bf7
 
 				case "domain":
-					value32e682360292482e, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen885b039f30e706f0[0], 2, "domain"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuec5e5de1d2c681923, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					s.Domain = value32e682360292482e
+					s.Domain = valuec5e5de1d2c681923
 
 				case "bytes":
-					value5dda353f277fcd7b, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen885b039f30e706f0[0], 3, "bytes"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value48ec1189fb2e3697, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					s.Bytes = value5dda353f277fcd7b
+					s.Bytes = value48ec1189fb2e3697
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -3638,19 +5867,44 @@ func (s *Server) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj03cca33e8082d5f8--
+					obj37f9296566557fab--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (s *SmallPayload) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := s.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (s *SmallPayload) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := s.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
 
+	return dst, nil
+}
+
+func (s *SmallPayload) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyInt("st", s.St)
 
@@ -3671,15 +5925,36 @@ func (s *SmallPayload) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyInt("v", s.V)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (s *SmallPayload) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := s.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (s *SmallPayload) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -3690,87 +5965,140 @@ func (s *SmallPayload) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for objdeb6a796154fb10d := 1; objdeb6a796154fb10d > 0; {
-				key675f5969d943948c, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen2801f6eaee414091 [1]uint64
+			for obj3cef09ff14be2392 := 1; obj3cef09ff14be2392 > 0; {
+				key58b45f2dec82d17c, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key675f5969d943948c {
+				switch key58b45f2dec82d17c {
 				case "st":
-					value6873b95e9920d97c, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen2801f6eaee414091[0], 0, "st"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valueaaba160cd640ff73, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					s.St = value6873b95e9920d97c
+					s.St = valueaaba160cd640ff73
 
 				case "sid":
-					value4a4911699e88583b, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen2801f6eaee414091[0], 1, "sid"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value495fe4a05ce1202c, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					s.Sid = value4a4911699e88583b
+					s.Sid = value495fe4a05ce1202c
 
 				case "tt":
-					value160c47148e45027e, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen2801f6eaee414091[0], 2, "tt"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuea7287ed3235b95e6, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					s.Tt = value160c47148e45027e
+					s.Tt = valuea7287ed3235b95e6
 
 				case "gr":
-					value6f48ca701bc9f800, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen2801f6eaee414091[0], 3, "gr"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value9f571fa5e656aaa5, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					s.Gr = value6f48ca701bc9f800
+					s.Gr = value9f571fa5e656aaa5
 
 				case "uuid":
-					value5f481cb9036330b8, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen2801f6eaee414091[0], 4, "uuid"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value1fae1ebdd7aa6269, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					s.Uuid = value5f481cb9036330b8
+					s.Uuid = value1fae1ebdd7aa6269
 
 				case "ip":
-					valuef134eb4d60733779, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen2801f6eaee414091[0], 5, "ip"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuec2ec7f4057b33593, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					s.Ip = valuef134eb4d60733779
+					s.Ip = valuec2ec7f4057b33593
 
 				case "ua":
-					valuedc2c26d9bb6bd29b, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen2801f6eaee414091[0], 6, "ua"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuebc84888c970fd528, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					s.Ua = valuedc2c26d9bb6bd29b
+					s.Ua = valuebc84888c970fd528
 
 				case "tz":
-					value5e1e0b7d548828b2, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen2801f6eaee414091[0], 7, "tz"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valued4a99a1eab9d2420, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					s.Tz = value5e1e0b7d548828b2
+					s.Tz = valued4a99a1eab9d2420
 
 				case "v":
-					value359929abd13685d3, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen2801f6eaee414091[0], 8, "v"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value134537cd6d02282e, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					s.V = value359929abd13685d3
+					s.V = value134537cd6d02282e
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -3778,19 +6106,44 @@ func (s *SmallPayload) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					objdeb6a796154fb10d--
+					obj3cef09ff14be2392--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (s *Source) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := s.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (s *Source) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := s.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
 
+	return dst, nil
+}
+
+func (s *Source) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyInt("bytes", s.Bytes)
 
@@ -3799,15 +6152,36 @@ func (s *Source) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyInt("port", s.Port)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (s *Source) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := s.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (s *Source) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -3818,39 +6192,56 @@ func (s *Source) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj9aa4a64637784afe := 1; obj9aa4a64637784afe > 0; {
-				key32536e3344c36ef5, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen383a21d1845c408a [1]uint64
+			for obj0981e140232a4a87 := 1; obj0981e140232a4a87 > 0; {
+				keyd757043813032a0b, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key32536e3344c36ef5 {
+				switch keyd757043813032a0b {
 				case "bytes":
-					value1051c7229b1b4877, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen383a21d1845c408a[0], 0, "bytes"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valued5a30dcca6e3aa2d, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					s.Bytes = value1051c7229b1b4877
+					s.Bytes = valued5a30dcca6e3aa2d
 
 				case "ip":
-					value5665d33dd8c09fc0, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen383a21d1845c408a[0], 1, "ip"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuef04715d879279a96, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					s.IP = value5665d33dd8c09fc0
+					s.IP = valuef04715d879279a96
 
 				case "port":
-					value2ba7fa4877b3db7c, err := dec.DecodeInt()
+					if dup, err := dec.Seen(&seen383a21d1845c408a[0], 2, "port"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value879a4f3690ac2025, err := dec.DecodeInt()
 					if err != nil {
 						return err
 					}
 
-					s.Port = value2ba7fa4877b3db7c
+					s.Port = value879a4f3690ac2025
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -3858,27 +6249,52 @@ func (s *Source) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj9aa4a64637784afe--
+					obj0981e140232a4a87--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (t *TestLargeStruct) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := t.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (t *TestLargeStruct) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := t.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (t *TestLargeStruct) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.WriteKey("@timestamp")
-	data7b56f84b8be3fe1a, err := t.Timestamp.MarshalJSON()
+	dataa60c7db15e0501eb, err := t.Timestamp.MarshalJSON()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	enc.WriteBytes(data7b56f84b8be3fe1a)
+	enc.WriteBytes(dataa60c7db15e0501eb)
 	enc.WriteKey("@metadata")
 	enc.WriteByte('{')
 	enc.EncodeKeyString("beat", t.Metadata.Beat)
@@ -3998,6 +6414,13 @@ func (t *TestLargeStruct) MarshalJSON() ([]byte, error) {
 	enc.WriteByte('}')
 	enc.EncodeKeyString("method", t.HTTP.Request.Method)
 
+	enc.WriteKey("body")
+	enc.WriteByte('{')
+	enc.EncodeKeyString("content", t.HTTP.Request.Body.Content)
+
+	enc.EncodeKeyInt("bytes", t.HTTP.Request.Body.Bytes)
+
+	enc.WriteByte('}')
 	enc.WriteByte('}')
 	enc.WriteByte('}')
 	enc.WriteKey("network")
@@ -4040,19 +6463,19 @@ func (t *TestLargeStruct) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyInt("duration", t.Event.Duration)
 
 	enc.WriteKey("start")
-	data75b8f5e2fe812665, err := t.Event.Start.MarshalJSON()
+	datac34b734355fe4a05, err := t.Event.Start.MarshalJSON()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	enc.WriteBytes(data75b8f5e2fe812665)
+	enc.WriteBytes(datac34b734355fe4a05)
 	enc.WriteKey("end")
-	datab1d63d9d247148e1, err := t.Event.End.MarshalJSON()
+	data9bd3899d920e95f1, err := t.Event.End.MarshalJSON()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	enc.WriteBytes(datab1d63d9d247148e1)
+	enc.WriteBytes(data9bd3899d920e95f1)
 	enc.EncodeKeyString("kind", t.Event.Kind)
 
 	enc.EncodeKeyString("category", t.Event.Category)
@@ -4094,15 +6517,36 @@ func (t *TestLargeStruct) MarshalJSON() ([]byte, error) {
 
 	enc.WriteByte('}')
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := t.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (t *TestLargeStruct) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -4113,27 +6557,38 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for objacf6d7ea085aed41 := 1; objacf6d7ea085aed41 > 0; {
-				key71e01605749c9525, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen7f9b38965d5a77a7 [1]uint64
+			for objc46d432f9b08e64d := 1; objc46d432f9b08e64d > 0; {
+				keyac183c3833e1a342, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key71e01605749c9525 {
+				switch keyac183c3833e1a342 {
 				case "@timestamp":
-					data2a41e966ef5ed50d, err := dec.ReadValue()
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 0, "@timestamp"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					data5ead69d4f975012f, err := dec.ReadValue()
 					if err != nil {
-						return nil
+						return err
 					}
 
-					if err := t.Timestamp.UnmarshalJSON(data2a41e966ef5ed50d); err != nil {
+					if err := t.Timestamp.UnmarshalJSON(data5ead69d4f975012f); err != nil {
 						return err
 					}
 
 				case "@metadata":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 1, "@metadata"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.Metadata = Metadata{}
 					} else if !dec.IsObjectOpen() {
@@ -4142,44 +6597,69 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.Metadata = Metadata{}
 						} else {
-							for obj6d3a69525fa883a2 := 1; obj6d3a69525fa883a2 > 0; {
-								keyc942e374af7353fb, err := dec.NextKey()
+							var seen9c63b453ec049c9e [1]uint64
+							for objd1a49ed832f69e6e := 1; objd1a49ed832f69e6e > 0; {
+								key7a5cf944232d1035, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch keyc942e374af7353fb {
+								switch key7a5cf944232d1035 {
 								case "beat":
-									valueca2558865a9002bd, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen9c63b453ec049c9e[0], 0, "beat"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value506ad3fdb1f4415b, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Metadata.Beat = valueca2558865a9002bd
+									t.Metadata.Beat = value506ad3fdb1f4415b
 
 								case "type":
-									valued4d53bda9b6e0230, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen9c63b453ec049c9e[0], 1, "type"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value0af9ce8c208bc20e, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Metadata.Type = valued4d53bda9b6e0230
+									t.Metadata.Type = value0af9ce8c208bc20e
 
 								case "version":
-									value05f9174609825075, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen9c63b453ec049c9e[0], 2, "version"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuee526741539fa3203, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Metadata.Version = value05f9174609825075
+									t.Metadata.Version = valuee526741539fa3203
 
 								case "topic":
-									value1b6993f466e230b1, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen9c63b453ec049c9e[0], 3, "topic"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuec77ecba410fd6718, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Metadata.Topic = value1b6993f466e230b1
+									t.Metadata.Topic = valuec77ecba410fd6718
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -4187,13 +6667,19 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj6d3a69525fa883a2--
+									objd1a49ed832f69e6e--
 								}
 							}
 						}
 					}
 
 				case "ecs":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 2, "ecs"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.Ecs = Ecs{}
 					} else if !dec.IsObjectOpen() {
@@ -4202,20 +6688,27 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.Ecs = Ecs{}
 						} else {
-							for obj4234aac118af54d1 := 1; obj4234aac118af54d1 > 0; {
-								key754d308254a1f7c2, err := dec.NextKey()
+							var seen49a3d38540dc2229 [1]uint64
+							for objf227e0b430f9bcb0 := 1; objf227e0b430f9bcb0 > 0; {
+								key69120ce80f2007cd, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key754d308254a1f7c2 {
+								switch key69120ce80f2007cd {
 								case "version":
-									value7e8837ca5ab80b3e, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen49a3d38540dc2229[0], 0, "version"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value7b45d4e428811984, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Ecs.Version = value7e8837ca5ab80b3e
+									t.Ecs.Version = value7b45d4e428811984
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -4223,13 +6716,19 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj4234aac118af54d1--
+									objf227e0b430f9bcb0--
 								}
 							}
 						}
 					}
 
 				case "host":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 3, "host"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.Host = Host{}
 					} else if !dec.IsObjectOpen() {
@@ -4238,20 +6737,27 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.Host = Host{}
 						} else {
-							for obj899e812efa8645d1 := 1; obj899e812efa8645d1 > 0; {
-								key8414b85bb8488852, err := dec.NextKey()
+							var seen15cefe0b002cee5e [1]uint64
+							for objecad349cc35dd935 := 1; objecad349cc35dd935 > 0; {
+								key71c47935e281ebfc, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key8414b85bb8488852 {
+								switch key71c47935e281ebfc {
 								case "name":
-									valuecf6d89f3479c9732, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen15cefe0b002cee5e[0], 0, "name"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuee55a20f1b9f97d04, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Host.Name = valuecf6d89f3479c9732
+									t.Host.Name = valuee55a20f1b9f97d04
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -4259,13 +6765,19 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj899e812efa8645d1--
+									objecad349cc35dd935--
 								}
 							}
 						}
 					}
 
 				case "server":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 4, "server"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.Server = Server{}
 					} else if !dec.IsObjectOpen() {
@@ -4274,44 +6786,69 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.Server = Server{}
 						} else {
-							for objb1211588858ca54b := 1; objb1211588858ca54b > 0; {
-								key0fc6d642baf6bd26, err := dec.NextKey()
+							var seen53e3bf9d19f825c3 [1]uint64
+							for obja86671cc180152b9 := 1; obja86671cc180152b9 > 0; {
+								keydd54ae1688e49efb, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key0fc6d642baf6bd26 {
+								switch keydd54ae1688e49efb {
 								case "ip":
-									value0c5b55619b85a7f0, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen53e3bf9d19f825c3[0], 0, "ip"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value60010e7c8c997cd5, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Server.IP = value0c5b55619b85a7f0
+									t.Server.IP = value60010e7c8c997cd5
 
 								case "port":
-									valuec5962e64af5704cd, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seen53e3bf9d19f825c3[0], 1, "port"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuef9e320ca7d39d4ba, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									t.Server.Port = valuec5962e64af5704cd
+									t.Server.Port = valuef9e320ca7d39d4ba
 
 								case "domain":
-									valueef1c40660f36e8e1, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen53e3bf9d19f825c3[0], 2, "domain"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value801a175b1c76f057, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Server.Domain = valueef1c40660f36e8e1
+									t.Server.Domain = value801a175b1c76f057
 
 								case "bytes":
-									value2d4b97c380cb8d7b, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seen53e3bf9d19f825c3[0], 3, "bytes"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value832f3f36d7d893e2, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									t.Server.Bytes = value2d4b97c380cb8d7b
+									t.Server.Bytes = value832f3f36d7d893e2
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -4319,21 +6856,33 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									objb1211588858ca54b--
+									obja86671cc180152b9--
 								}
 							}
 						}
 					}
 
 				case "status":
-					value37637e74c072640b, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 5, "status"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value16e4c7bbdb548d0b, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					t.Status = value37637e74c072640b
+					t.Status = value16e4c7bbdb548d0b
 
 				case "source":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 6, "source"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.Source = Source{}
 					} else if !dec.IsObjectOpen() {
@@ -4342,36 +6891,55 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.Source = Source{}
 						} else {
-							for obj6e0cb4ec873f39fe := 1; obj6e0cb4ec873f39fe > 0; {
-								keybb90dd75f63864d0, err := dec.NextKey()
+							var seen34f9c69776b45915 [1]uint64
+							for obja48449330027368b := 1; obja48449330027368b > 0; {
+								key32da1c5be68ef4ee, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch keybb90dd75f63864d0 {
+								switch key32da1c5be68ef4ee {
 								case "bytes":
-									value6404d77fb7055259, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seen34f9c69776b45915[0], 0, "bytes"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valueb70c2c896334cb1f, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									t.Source.Bytes = value6404d77fb7055259
+									t.Source.Bytes = valueb70c2c896334cb1f
 
 								case "ip":
-									valuebec322857938087d, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen34f9c69776b45915[0], 1, "ip"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value9cb5dfe044fa0861, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Source.IP = valuebec322857938087d
+									t.Source.IP = value9cb5dfe044fa0861
 
 								case "port":
-									value8cd34b7a03e85d0b, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seen34f9c69776b45915[0], 2, "port"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value97ff5dfd02f2ba38, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									t.Source.Port = value8cd34b7a03e85d0b
+									t.Source.Port = value97ff5dfd02f2ba38
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -4379,21 +6947,33 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj6e0cb4ec873f39fe--
+									obja48449330027368b--
 								}
 							}
 						}
 					}
 
 				case "method":
-					value737ccad071225318, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 7, "method"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value84c53dd718c8560d, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					t.Method = value737ccad071225318
+					t.Method = value84c53dd718c8560d
 
 				case "http":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 8, "http"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.HTTP = HTTP{}
 					} else if !dec.IsObjectOpen() {
@@ -4402,14 +6982,21 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.HTTP = HTTP{}
 						} else {
-							for obj21a019c02dcdbf83 := 1; obj21a019c02dcdbf83 > 0; {
-								key5b2ef298477e1356, err := dec.NextKey()
+							var seenccef002d82ca3525 [1]uint64
+							for obja743a8e9d4aeae20 := 1; obja743a8e9d4aeae20 > 0; {
+								key92b8d8f2a8df3b0c, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key5b2ef298477e1356 {
+								switch key92b8d8f2a8df3b0c {
 								case "response":
+									if dup, err := dec.Seen(&seenccef002d82ca3525[0], 0, "response"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
 									if dec.IsNull() {
 										t.HTTP.Response = Response{}
 									} else if !dec.IsObjectOpen() {
@@ -4418,22 +7005,35 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 										if dec.IsObjectClose() {
 											t.HTTP.Response = Response{}
 										} else {
-											for objf09977aaf07a7877 := 1; objf09977aaf07a7877 > 0; {
-												key24e5c2d4bcdff4a5, err := dec.NextKey()
+											var seen94f2dd5c08731f52 [1]uint64
+											for objd4ca8e9a133eb520 := 1; objd4ca8e9a133eb520 > 0; {
+												key315d828846e37df6, err := dec.NextKey()
 												if err != nil {
 													return err
 												}
 
-												switch key24e5c2d4bcdff4a5 {
+												switch key315d828846e37df6 {
 												case "status_code":
-													value4b5f55e9110c2ea7, err := dec.DecodeInt()
+													if dup, err := dec.Seen(&seen94f2dd5c08731f52[0], 0, "status_code"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value84233633957e688e, err := dec.DecodeInt()
 													if err != nil {
 														return err
 													}
 
-													t.HTTP.Response.StatusCode = value4b5f55e9110c2ea7
+													t.HTTP.Response.StatusCode = value84233633957e688e
 
 												case "body":
+													if dup, err := dec.Seen(&seen94f2dd5c08731f52[0], 1, "body"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
 													if dec.IsNull() {
 														t.HTTP.Response.Body = Body{}
 													} else if !dec.IsObjectOpen() {
@@ -4442,28 +7042,41 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 														if dec.IsObjectClose() {
 															t.HTTP.Response.Body = Body{}
 														} else {
-															for obj8ee0be0737f45707 := 1; obj8ee0be0737f45707 > 0; {
-																key719e1f36922bd8ed, err := dec.NextKey()
+															var seenfd8a56da8bb07daa [1]uint64
+															for obj924ffe3713b52c76 := 1; obj924ffe3713b52c76 > 0; {
+																key8eb4eb8f7334f992, err := dec.NextKey()
 																if err != nil {
 																	return err
 																}
 
-																switch key719e1f36922bd8ed {
+																switch key8eb4eb8f7334f992 {
 																case "content":
-																	value725c4a0f9772300e, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seenfd8a56da8bb07daa[0], 0, "content"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valueed424f0f743543cd, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Response.Body.Content = value725c4a0f9772300e
+																	t.HTTP.Response.Body.Content = valueed424f0f743543cd
 
 																case "bytes":
-																	value712ecf175c040731, err := dec.DecodeInt()
+																	if dup, err := dec.Seen(&seenfd8a56da8bb07daa[0], 1, "bytes"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valueea66e5baaa03edc9, err := dec.DecodeInt()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Response.Body.Bytes = value712ecf175c040731
+																	t.HTTP.Response.Body.Bytes = valueea66e5baaa03edc9
 
 																default:
 																	if err := dec.SkipValue(); err != nil {
@@ -4471,21 +7084,33 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 																	}
 																}
 																if dec.IsObjectClose() {
-																	obj8ee0be0737f45707--
+																	obj924ffe3713b52c76--
 																}
 															}
 														}
 													}
 
 												case "bytes":
-													valued8b7358c804dfdf2, err := dec.DecodeInt()
+													if dup, err := dec.Seen(&seen94f2dd5c08731f52[0], 2, "bytes"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value18e8305bb19fc0c6, err := dec.DecodeInt()
 													if err != nil {
 														return err
 													}
 
-													t.HTTP.Response.Bytes = valued8b7358c804dfdf2
+													t.HTTP.Response.Bytes = value18e8305bb19fc0c6
 
 												case "headers":
+													if dup, err := dec.Seen(&seen94f2dd5c08731f52[0], 3, "headers"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
 													if dec.IsNull() {
 														t.HTTP.Response.Headers = ResponseHeaders{}
 													} else if !dec.IsObjectOpen() {
@@ -4494,68 +7119,111 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 														if dec.IsObjectClose() {
 															t.HTTP.Response.Headers = ResponseHeaders{}
 														} else {
-															for objc7c0cbf54084bdde := 1; objc7c0cbf54084bdde > 0; {
-																key5beff1eabaf85a2b, err := dec.NextKey()
+															var seen90940fc6d4cabe21 [1]uint64
+															for objb4ddb4aa3886cb50 := 1; objb4ddb4aa3886cb50 > 0; {
+																key53809e4ed60a0e2a, err := dec.NextKey()
 																if err != nil {
 																	return err
 																}
 
-																switch key5beff1eabaf85a2b {
+																switch key53809e4ed60a0e2a {
 																case "content-length":
-																	valueba761c1172023b1d, err := dec.DecodeInt()
+																	if dup, err := dec.Seen(&seen90940fc6d4cabe21[0], 0, "content-length"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value7a578a27cbdc20a1, err := dec.DecodeInt()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Response.Headers.ContentLength = valueba761c1172023b1d
+																	t.HTTP.Response.Headers.ContentLength = value7a578a27cbdc20a1
 
 																case "transfer-encoding":
-																	value77472334420ef065, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen90940fc6d4cabe21[0], 1, "transfer-encoding"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value759f76b0889a83ce, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Response.Headers.TransferEncoding = value77472334420ef065
+																	t.HTTP.Response.Headers.TransferEncoding = value759f76b0889a83ce
 
 																case "connection":
-																	value73cb88b059d864c0, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen90940fc6d4cabe21[0], 2, "connection"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value25ce3ca91a4eb5c2, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Response.Headers.Connection = value73cb88b059d864c0
+																	t.HTTP.Response.Headers.Connection = value25ce3ca91a4eb5c2
 
 																case "cache-control":
-																	value95f68c8e0c5e732e, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen90940fc6d4cabe21[0], 3, "cache-control"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valuef8580819da04d02c, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Response.Headers.CacheControl = value95f68c8e0c5e732e
+																	t.HTTP.Response.Headers.CacheControl = valuef8580819da04d02c
 
 																case "pragma":
-																	value1971c87ea5452c0f, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen90940fc6d4cabe21[0], 4, "pragma"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value41770c01746de44f, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Response.Headers.Pragma = value1971c87ea5452c0f
+																	t.HTTP.Response.Headers.Pragma = value41770c01746de44f
 
 																case "server":
-																	value880c80e3146264b5, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen90940fc6d4cabe21[0], 5, "server"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value3db6e3402e7873db, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Response.Headers.Server = value880c80e3146264b5
+																	t.HTTP.Response.Headers.Server = value3db6e3402e7873db
 
 																case "date":
-																	valued0749b088679e0fb, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen90940fc6d4cabe21[0], 6, "date"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value7635516e87b33e4b, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Response.Headers.Date = valued0749b088679e0fb
+																	t.HTTP.Response.Headers.Date = value7635516e87b33e4b
 
 																default:
 																	if err := dec.SkipValue(); err != nil {
@@ -4563,19 +7231,25 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 																	}
 																}
 																if dec.IsObjectClose() {
-																	objc7c0cbf54084bdde--
+																	objb4ddb4aa3886cb50--
 																}
 															}
 														}
 													}
 
 												case "status_phrase":
-													valueb8cff998aaf24192, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seen94f2dd5c08731f52[0], 4, "status_phrase"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value412ba3df68544920, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													t.HTTP.Response.StatusPhrase = valueb8cff998aaf24192
+													t.HTTP.Response.StatusPhrase = value412ba3df68544920
 
 												default:
 													if err := dec.SkipValue(); err != nil {
@@ -4583,21 +7257,33 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 													}
 												}
 												if dec.IsObjectClose() {
-													objf09977aaf07a7877--
+													objd4ca8e9a133eb520--
 												}
 											}
 										}
 									}
 
 								case "version":
-									value4f32763f47763ba1, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seenccef002d82ca3525[0], 1, "version"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuef5ea27ec09771095, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.HTTP.Version = value4f32763f47763ba1
+									t.HTTP.Version = valuef5ea27ec09771095
 
 								case "request":
+									if dup, err := dec.Seen(&seenccef002d82ca3525[0], 2, "request"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
 									if dec.IsNull() {
 										t.HTTP.Request = Request{}
 									} else if !dec.IsObjectOpen() {
@@ -4606,30 +7292,49 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 										if dec.IsObjectClose() {
 											t.HTTP.Request = Request{}
 										} else {
-											for objf0590d13a8d62d88 := 1; objf0590d13a8d62d88 > 0; {
-												key3536b1d2b6094c70, err := dec.NextKey()
+											var seen14c064b411253867 [1]uint64
+											for obj4f42158bdba66d48 := 1; obj4f42158bdba66d48 > 0; {
+												key6095467c89ba98e6, err := dec.NextKey()
 												if err != nil {
 													return err
 												}
 
-												switch key3536b1d2b6094c70 {
+												switch key6095467c89ba98e6 {
 												case "referrer":
-													value9537ae709edbdcfd, err := dec.DecodeString()
+													if dup, err := dec.Seen(&seen14c064b411253867[0], 0, "referrer"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													valuedf5cc36d09c7a647, err := dec.DecodeString()
 													if err != nil {
 														return err
 													}
 
-													t.HTTP.Request.Referrer = value9537ae709edbdcfd
+													t.HTTP.Request.Referrer = valuedf5cc36d09c7a647
 
 												case "bytes":
-													valueb35df7f03b25ffdc, err := dec.DecodeInt()
+													if dup, err := dec.Seen(&seen14c064b411253867[0], 1, "bytes"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value2a41f29c380a987b, err := dec.DecodeInt()
 													if err != nil {
 														return err
 													}
 
-													t.HTTP.Request.Bytes = valueb35df7f03b25ffdc
+													t.HTTP.Request.Bytes = value2a41f29c380a987b
 
 												case "headers":
+													if dup, err := dec.Seen(&seen14c064b411253867[0], 2, "headers"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
 													if dec.IsNull() {
 														t.HTTP.Request.Headers = RequestHeaders{}
 													} else if !dec.IsObjectOpen() {
@@ -4638,116 +7343,272 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 														if dec.IsObjectClose() {
 															t.HTTP.Request.Headers = RequestHeaders{}
 														} else {
-															for obj49bbde101f23b346 := 1; obj49bbde101f23b346 > 0; {
-																keye448fdf149fd4ecc, err := dec.NextKey()
+															var seen3ceefc1c02181f57 [1]uint64
+															for obj1ecdcf84765f4e5d := 1; obj1ecdcf84765f4e5d > 0; {
+																key0f44fcd629f08dc1, err := dec.NextKey()
 																if err != nil {
 																	return err
 																}
 
-																switch keye448fdf149fd4ecc {
+																switch key0f44fcd629f08dc1 {
 																case "referer":
-																	valuef1bbeb0beed8a8b9, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 0, "referer"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value67fdc7a2c67b425f, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Request.Headers.Referer = valuef1bbeb0beed8a8b9
+																	t.HTTP.Request.Headers.Referer = value67fdc7a2c67b425f
 
 																case "x-requested-with":
-																	value02a49835d2288e3e, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 1, "x-requested-with"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value13c5be8d9f630c1d, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Request.Headers.XRequestedWith = value02a49835d2288e3e
+																	t.HTTP.Request.Headers.XRequestedWith = value13c5be8d9f630c1d
 
 																case "yz_client_ip":
-																	valueb9eb349377976d03, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 2, "yz_client_ip"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value063c02fd75cf64c1, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Request.Headers.YzClientIP = valueb9eb349377976d03
+																	t.HTTP.Request.Headers.YzClientIP = value063c02fd75cf64c1
 
 																case "user-agent":
-																	valuef826a4c51d1bd5ff, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 3, "user-agent"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valueaec9d2e2ef6e6431, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Request.Headers.UserAgent = valuef826a4c51d1bd5ff
+																	t.HTTP.Request.Headers.UserAgent = valueaec9d2e2ef6e6431
 
 																case "accept-language":
-																	value4bfcb845e7ec9039, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 4, "accept-language"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valued5f5ad0489078dc6, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Request.Headers.AcceptLanguage = value4bfcb845e7ec9039
+																	t.HTTP.Request.Headers.AcceptLanguage = valued5f5ad0489078dc6
 
 																case "content-length":
-																	value5dc03b0b323c4247, err := dec.DecodeInt()
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 5, "content-length"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value1f46494dccf403da, err := dec.DecodeInt()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Request.Headers.ContentLength = value5dc03b0b323c4247
+																	t.HTTP.Request.Headers.ContentLength = value1f46494dccf403da
 
 																case "x-real-ip":
-																	value7ec90f60d30f11c5, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 6, "x-real-ip"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valued7f094170d2c3e29, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Request.Headers.XRealIP = value7ec90f60d30f11c5
+																	t.HTTP.Request.Headers.XRealIP = valued7f094170d2c3e29
 
 																case "pragma":
-																	value1bc62ebeecac8620, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 7, "pragma"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valuec198b0f341e284c4, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Request.Headers.Pragma = value1bc62ebeecac8620
+																	t.HTTP.Request.Headers.Pragma = valuec198b0f341e284c4
 
 																case "connection":
-																	value0571f1933d1a2863, err := dec.DecodeString()
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 8, "connection"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valuebe8fa60c1a478d6b, err := dec.DecodeString()
+																	if err != nil {
+																		return err
+																	}
+
+																	t.HTTP.Request.Headers.Connection = valuebe8fa60c1a478d6b
+
+																case "accept":
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 9, "accept"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valued55dd2c04dad86d2, err := dec.DecodeString()
+																	if err != nil {
+																		return err
+																	}
+
+																	t.HTTP.Request.Headers.Accept = valued55dd2c04dad86d2
+
+																case "host":
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 10, "host"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	value053d5d25b014e3d8, err := dec.DecodeString()
+																	if err != nil {
+																		return err
+																	}
+
+																	t.HTTP.Request.Headers.Host = value053d5d25b014e3d8
+
+																case "x-forwarded-for":
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 11, "x-forwarded-for"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valueb64322cdcb5004fa, err := dec.DecodeString()
+																	if err != nil {
+																		return err
+																	}
+
+																	t.HTTP.Request.Headers.XForwardedFor = valueb64322cdcb5004fa
+
+																case "content-type":
+																	if dup, err := dec.Seen(&seen3ceefc1c02181f57[0], 12, "content-type"); err != nil {
+																		return err
+																	} else if dup {
+																		break
+																	}
+
+																	valuea46cfa2d6ad2ff93, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Request.Headers.Connection = value0571f1933d1a2863
+																	t.HTTP.Request.Headers.ContentType = valuea46cfa2d6ad2ff93
+
+																default:
+																	if err := dec.SkipValue(); err != nil {
+																		return err
+																	}
+																}
+																if dec.IsObjectClose() {
+																	obj1ecdcf84765f4e5d--
+																}
+															}
+														}
+													}
+
+												case "method":
+													if dup, err := dec.Seen(&seen14c064b411253867[0], 3, "method"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													value3bc3bd9a5a74660a, err := dec.DecodeString()
+													if err != nil {
+														return err
+													}
+
+													t.HTTP.Request.Method = value3bc3bd9a5a74660a
 
-																case "accept":
-																	value4d0b240fcbb423f4, err := dec.DecodeString()
-																	if err != nil {
+												case "body":
+													if dup, err := dec.Seen(&seen14c064b411253867[0], 4, "body"); err != nil {
+														return err
+													} else if dup {
+														break
+													}
+
+													if dec.IsNull() {
+														t.HTTP.Request.Body = Body{}
+													} else if !dec.IsObjectOpen() {
+														return errors.NewParseError(dec.Char(), dec.Cursor())
+													} else {
+														if dec.IsObjectClose() {
+															t.HTTP.Request.Body = Body{}
+														} else {
+															var seen250427d9a6219197 [1]uint64
+															for objf3d048a9a43634c0 := 1; objf3d048a9a43634c0 > 0; {
+																keya3f3633f841753ba, err := dec.NextKey()
+																if err != nil {
+																	return err
+																}
+
+																switch keya3f3633f841753ba {
+																case "content":
+																	if dup, err := dec.Seen(&seen250427d9a6219197[0], 0, "content"); err != nil {
 																		return err
+																	} else if dup {
+																		break
 																	}
 
-																	t.HTTP.Request.Headers.Accept = value4d0b240fcbb423f4
-
-																case "host":
-																	valueee747ab6677c2767, err := dec.DecodeString()
+																	value1a6cb9c1dc227674, err := dec.DecodeString()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Request.Headers.Host = valueee747ab6677c2767
+																	t.HTTP.Request.Body.Content = value1a6cb9c1dc227674
 
-																case "x-forwarded-for":
-																	value80cabd0b43e380ca, err := dec.DecodeString()
-																	if err != nil {
+																case "bytes":
+																	if dup, err := dec.Seen(&seen250427d9a6219197[0], 1, "bytes"); err != nil {
 																		return err
+																	} else if dup {
+																		break
 																	}
 
-																	t.HTTP.Request.Headers.XForwardedFor = value80cabd0b43e380ca
-
-																case "content-type":
-																	value8ea0c65a7064f96a, err := dec.DecodeString()
+																	valueaa020724d137da2c, err := dec.DecodeInt()
 																	if err != nil {
 																		return err
 																	}
 
-																	t.HTTP.Request.Headers.ContentType = value8ea0c65a7064f96a
+																	t.HTTP.Request.Body.Bytes = valueaa020724d137da2c
 
 																default:
 																	if err := dec.SkipValue(); err != nil {
@@ -4755,27 +7616,19 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 																	}
 																}
 																if dec.IsObjectClose() {
-																	obj49bbde101f23b346--
+																	objf3d048a9a43634c0--
 																}
 															}
 														}
 													}
 
-												case "method":
-													value095f3a537c437670, err := dec.DecodeString()
-													if err != nil {
-														return err
-													}
-
-													t.HTTP.Request.Method = value095f3a537c437670
-
 												default:
 													if err := dec.SkipValue(); err != nil {
 														return err
 													}
 												}
 												if dec.IsObjectClose() {
-													objf0590d13a8d62d88--
+													obj4f42158bdba66d48--
 												}
 											}
 										}
@@ -4787,13 +7640,19 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj21a019c02dcdbf83--
+									obja743a8e9d4aeae20--
 								}
 							}
 						}
 					}
 
 				case "network":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 9, "network"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.Network = Network{}
 					} else if !dec.IsObjectOpen() {
@@ -4802,52 +7661,83 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.Network = Network{}
 						} else {
-							for obj774c3bdb8fe3f66a := 1; obj774c3bdb8fe3f66a > 0; {
-								keyd13816b35f365849, err := dec.NextKey()
+							var seena4747dd1e17d02c9 [1]uint64
+							for objb87b1615d512974f := 1; objb87b1615d512974f > 0; {
+								key462a44fec150ca3a, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch keyd13816b35f365849 {
+								switch key462a44fec150ca3a {
 								case "type":
-									valuefde49d0f3bc23761, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seena4747dd1e17d02c9[0], 0, "type"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value4299565e108535b1, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Network.Type = valuefde49d0f3bc23761
+									t.Network.Type = value4299565e108535b1
 
 								case "transport":
-									valued9ce97a7779597a6, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seena4747dd1e17d02c9[0], 1, "transport"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuef62e1d4ba18e17a5, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Network.Transport = valued9ce97a7779597a6
+									t.Network.Transport = valuef62e1d4ba18e17a5
 
 								case "protocol":
-									value96e4dde63c123a40, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seena4747dd1e17d02c9[0], 2, "protocol"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value2164418bfd1a933f, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Network.Protocol = value96e4dde63c123a40
+									t.Network.Protocol = value2164418bfd1a933f
 
 								case "community_id":
-									value33a8753fd9180a34, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seena4747dd1e17d02c9[0], 3, "community_id"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value7fb3a126c860830a, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Network.CommunityID = value33a8753fd9180a34
+									t.Network.CommunityID = value7fb3a126c860830a
 
 								case "bytes":
-									value2769552de762a5be, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seena4747dd1e17d02c9[0], 4, "bytes"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value87293d9271da736e, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									t.Network.Bytes = value2769552de762a5be
+									t.Network.Bytes = value87293d9271da736e
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -4855,13 +7745,19 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj774c3bdb8fe3f66a--
+									objb87b1615d512974f--
 								}
 							}
 						}
 					}
 
 				case "url":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 10, "url"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.URL = URL{}
 					} else if !dec.IsObjectOpen() {
@@ -4870,52 +7766,83 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.URL = URL{}
 						} else {
-							for objdf37ea4beb5cc8c2 := 1; objdf37ea4beb5cc8c2 > 0; {
-								key83b98047298e55b5, err := dec.NextKey()
+							var seenf02786e1faf4b610 [1]uint64
+							for obj4398c1e37fb75c4b := 1; obj4398c1e37fb75c4b > 0; {
+								keycd1377fbb9ae1806, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key83b98047298e55b5 {
+								switch keycd1377fbb9ae1806 {
 								case "path":
-									valuef355d8251659a6a4, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seenf02786e1faf4b610[0], 0, "path"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value9473469f1eca5a66, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.URL.Path = valuef355d8251659a6a4
+									t.URL.Path = value9473469f1eca5a66
 
 								case "query":
-									value6481e8a2cf861cda, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seenf02786e1faf4b610[0], 1, "query"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valued53fa3dc7cd3e7c3, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.URL.Query = value6481e8a2cf861cda
+									t.URL.Query = valued53fa3dc7cd3e7c3
 
 								case "full":
-									value2b22c94c964f9a53, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seenf02786e1faf4b610[0], 2, "full"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valueb0411d7e145f96eb, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.URL.Full = value2b22c94c964f9a53
+									t.URL.Full = valueb0411d7e145f96eb
 
 								case "scheme":
-									value7c3f2b4f57cce6d4, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seenf02786e1faf4b610[0], 3, "scheme"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value9654ab94913dda50, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.URL.Scheme = value7c3f2b4f57cce6d4
+									t.URL.Scheme = value9654ab94913dda50
 
 								case "domain":
-									valuea940bc33dfc4fba6, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seenf02786e1faf4b610[0], 4, "domain"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value3a50f9e773842f4d, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.URL.Domain = valuea940bc33dfc4fba6
+									t.URL.Domain = value3a50f9e773842f4d
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -4923,13 +7850,19 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									objdf37ea4beb5cc8c2--
+									obj4398c1e37fb75c4b--
 								}
 							}
 						}
 					}
 
 				case "client":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 11, "client"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.Client = Client{}
 					} else if !dec.IsObjectOpen() {
@@ -4938,36 +7871,55 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.Client = Client{}
 						} else {
-							for obj4c3a26576c1b3930 := 1; obj4c3a26576c1b3930 > 0; {
-								key57770420d56d8951, err := dec.NextKey()
+							var seen830511f2ededd03e [1]uint64
+							for obj2a5faa60869bf365 := 1; obj2a5faa60869bf365 > 0; {
+								key0a73000edb60c9a2, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key57770420d56d8951 {
+								switch key0a73000edb60c9a2 {
 								case "bytes":
-									value7bdb684a341f30dc, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seen830511f2ededd03e[0], 0, "bytes"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value7a694690384599d1, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									t.Client.Bytes = value7bdb684a341f30dc
+									t.Client.Bytes = value7a694690384599d1
 
 								case "ip":
-									valuec8c83d82ba397c37, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen830511f2ededd03e[0], 1, "ip"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value16f8d2fd93b2aed5, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Client.IP = valuec8c83d82ba397c37
+									t.Client.IP = value16f8d2fd93b2aed5
 
 								case "port":
-									value923611deafc1d091, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seen830511f2ededd03e[0], 2, "port"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value5b7d44b5b054f3f3, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									t.Client.Port = value923611deafc1d091
+									t.Client.Port = value5b7d44b5b054f3f3
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -4975,13 +7927,19 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj4c3a26576c1b3930--
+									obj2a5faa60869bf365--
 								}
 							}
 						}
 					}
 
 				case "event":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 12, "event"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.Event = Event{}
 					} else if !dec.IsObjectOpen() {
@@ -4990,64 +7948,101 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.Event = Event{}
 						} else {
-							for obje1da0bf696e6e6ee := 1; obje1da0bf696e6e6ee > 0; {
-								keye2fc3b80fa8db0ea, err := dec.NextKey()
+							var seen568c41d1052cad0f [1]uint64
+							for obj8e788e4fdf36e591 := 1; obj8e788e4fdf36e591 > 0; {
+								keycb68ca4c4bf5090d, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch keye2fc3b80fa8db0ea {
+								switch keycb68ca4c4bf5090d {
 								case "duration":
-									value4d855a27a816098c, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seen568c41d1052cad0f[0], 0, "duration"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valueb11b804f331adb7e, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									t.Event.Duration = value4d855a27a816098c
+									t.Event.Duration = valueb11b804f331adb7e
 
 								case "start":
-									data2ec1ef403bfa68af, err := dec.ReadValue()
+									if dup, err := dec.Seen(&seen568c41d1052cad0f[0], 1, "start"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									datafb087a5604e9e22b, err := dec.ReadValue()
 									if err != nil {
-										return nil
+										return err
 									}
 
-									if err := t.Event.Start.UnmarshalJSON(data2ec1ef403bfa68af); err != nil {
+									if err := t.Event.Start.UnmarshalJSON(datafb087a5604e9e22b); err != nil {
 										return err
 									}
 
 								case "end":
-									data0c2387a9270534dc, err := dec.ReadValue()
+									if dup, err := dec.Seen(&seen568c41d1052cad0f[0], 2, "end"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									data4d54db40bcbc6e27, err := dec.ReadValue()
 									if err != nil {
-										return nil
+										return err
 									}
 
-									if err := t.Event.End.UnmarshalJSON(data0c2387a9270534dc); err != nil {
+									if err := t.Event.End.UnmarshalJSON(data4d54db40bcbc6e27); err != nil {
 										return err
 									}
 
 								case "kind":
-									value362491086ad54de9, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen568c41d1052cad0f[0], 3, "kind"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value2ff5eaddfc147145, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Event.Kind = value362491086ad54de9
+									t.Event.Kind = value2ff5eaddfc147145
 
 								case "category":
-									value20e5e1dc2d574894, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen568c41d1052cad0f[0], 4, "category"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value9e59f0554c582513, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Event.Category = value20e5e1dc2d574894
+									t.Event.Category = value9e59f0554c582513
 
 								case "dataset":
-									value386d3cee0e6db348, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen568c41d1052cad0f[0], 5, "dataset"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value42134a8daaef1498, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Event.Dataset = value386d3cee0e6db348
+									t.Event.Dataset = value42134a8daaef1498
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -5055,21 +8050,33 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obje1da0bf696e6e6ee--
+									obj8e788e4fdf36e591--
 								}
 							}
 						}
 					}
 
 				case "query":
-					value0ba4e853212d5d24, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 13, "query"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value069ba581ef1da251, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					t.Query = value0ba4e853212d5d24
+					t.Query = value069ba581ef1da251
 
 				case "user_agent":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 14, "user_agent"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.UserAgent = UserAgent{}
 					} else if !dec.IsObjectOpen() {
@@ -5078,20 +8085,27 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.UserAgent = UserAgent{}
 						} else {
-							for obj26876550d30262a2 := 1; obj26876550d30262a2 > 0; {
-								key49fbc364783f4788, err := dec.NextKey()
+							var seen111c79a6f0195fc3 [1]uint64
+							for obj0be92843487a4eb8 := 1; obj0be92843487a4eb8 > 0; {
+								key8ad6aee93c1df2b5, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key49fbc364783f4788 {
+								switch key8ad6aee93c1df2b5 {
 								case "original":
-									value70eaf5a5b2d26658, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen111c79a6f0195fc3[0], 0, "original"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value2fe0e3aa3e6accbf, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.UserAgent.Original = value70eaf5a5b2d26658
+									t.UserAgent.Original = value2fe0e3aa3e6accbf
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -5099,13 +8113,19 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj26876550d30262a2--
+									obj0be92843487a4eb8--
 								}
 							}
 						}
 					}
 
 				case "destination":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 15, "destination"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.Destination = Destination{}
 					} else if !dec.IsObjectOpen() {
@@ -5114,44 +8134,69 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.Destination = Destination{}
 						} else {
-							for obj40c4f5dd2210d1a5 := 1; obj40c4f5dd2210d1a5 > 0; {
-								keydacab648e2e92101, err := dec.NextKey()
+							var seenc61c861b96ca65e3 [1]uint64
+							for objd4c16d468433185f := 1; objd4c16d468433185f > 0; {
+								key4d31f24d6f56ee85, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch keydacab648e2e92101 {
+								switch key4d31f24d6f56ee85 {
 								case "ip":
-									valuece7c93f6ef7589d0, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seenc61c861b96ca65e3[0], 0, "ip"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuec15322f1c97613c0, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Destination.IP = valuece7c93f6ef7589d0
+									t.Destination.IP = valuec15322f1c97613c0
 
 								case "port":
-									value1e4e31fbe12990a2, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seenc61c861b96ca65e3[0], 1, "port"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value79eae292ba966e10, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									t.Destination.Port = value1e4e31fbe12990a2
+									t.Destination.Port = value79eae292ba966e10
 
 								case "domain":
-									valueb50a2f0a90a431f6, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seenc61c861b96ca65e3[0], 2, "domain"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valued1e700164e518b24, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Destination.Domain = valueb50a2f0a90a431f6
+									t.Destination.Domain = valued1e700164e518b24
 
 								case "bytes":
-									value7d022286b2481a42, err := dec.DecodeInt()
+									if dup, err := dec.Seen(&seenc61c861b96ca65e3[0], 3, "bytes"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value3f424c46f9ea63db, err := dec.DecodeInt()
 									if err != nil {
 										return err
 									}
 
-									t.Destination.Bytes = value7d022286b2481a42
+									t.Destination.Bytes = value3f424c46f9ea63db
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -5159,21 +8204,33 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj40c4f5dd2210d1a5--
+									objd4c16d468433185f--
 								}
 							}
 						}
 					}
 
 				case "type":
-					value5eafea1ea6077619, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 16, "type"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value1c2c34b512c403c1, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					t.Type = value5eafea1ea6077619
+					t.Type = value1c2c34b512c403c1
 
 				case "agent":
+					if dup, err := dec.Seen(&seen7f9b38965d5a77a7[0], 17, "agent"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
 					if dec.IsNull() {
 						t.Agent = Agent{}
 					} else if !dec.IsObjectOpen() {
@@ -5182,52 +8239,83 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 						if dec.IsObjectClose() {
 							t.Agent = Agent{}
 						} else {
-							for obj2ace1eca6b261f37 := 1; obj2ace1eca6b261f37 > 0; {
-								key33625901a9231d3b, err := dec.NextKey()
+							var seen7b805a072512a5e4 [1]uint64
+							for obj28ee19030a622651 := 1; obj28ee19030a622651 > 0; {
+								keycd274b7fd1fa23f8, err := dec.NextKey()
 								if err != nil {
 									return err
 								}
 
-								switch key33625901a9231d3b {
+								switch keycd274b7fd1fa23f8 {
 								case "hostname":
-									value51de06cbd672405f, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen7b805a072512a5e4[0], 0, "hostname"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value41039c74036b5b3d, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Agent.Hostname = value51de06cbd672405f
+									t.Agent.Hostname = value41039c74036b5b3d
 
 								case "id":
-									value3c3c36931ef8f499, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen7b805a072512a5e4[0], 1, "id"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									valuea8b1a0b93135a710, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Agent.ID = value3c3c36931ef8f499
+									t.Agent.ID = valuea8b1a0b93135a710
 
 								case "version":
-									value79d2a15548bf3827, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen7b805a072512a5e4[0], 2, "version"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value352da0f6c31203a0, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Agent.Version = value79d2a15548bf3827
+									t.Agent.Version = value352da0f6c31203a0
 
 								case "type":
-									value70f62bac563f5b84, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen7b805a072512a5e4[0], 3, "type"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value9d1f2329651bb3ab, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Agent.Type = value70f62bac563f5b84
+									t.Agent.Type = value9d1f2329651bb3ab
 
 								case "ephemeral_id":
-									value23307611653f4959, err := dec.DecodeString()
+									if dup, err := dec.Seen(&seen7b805a072512a5e4[0], 4, "ephemeral_id"); err != nil {
+										return err
+									} else if dup {
+										break
+									}
+
+									value3984ab591f2247e7, err := dec.DecodeString()
 									if err != nil {
 										return err
 									}
 
-									t.Agent.EphemeralID = value23307611653f4959
+									t.Agent.EphemeralID = value3984ab591f2247e7
 
 								default:
 									if err := dec.SkipValue(); err != nil {
@@ -5235,7 +8323,7 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 									}
 								}
 								if dec.IsObjectClose() {
-									obj2ace1eca6b261f37--
+									obj28ee19030a622651--
 								}
 							}
 						}
@@ -5247,32 +8335,78 @@ func (t *TestLargeStruct) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					objacf6d7ea085aed41--
+					objc46d432f9b08e64d--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (t *TestStruct) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := t.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (t *TestStruct) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := t.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (t *TestStruct) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("a", t.A)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (t *TestStruct) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := t.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (t *TestStruct) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -5283,23 +8417,28 @@ func (t *TestStruct) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for objde7244b4e23c4682 := 1; objde7244b4e23c4682 > 0; {
-				key39e960f6a8d4d410, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen6d8595f7aef9bf39 [1]uint64
+			for obj1cd44835e7a1a1b6 := 1; obj1cd44835e7a1a1b6 > 0; {
+				keyd1417d2d31ea3599, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key39e960f6a8d4d410 {
+				switch keyd1417d2d31ea3599 {
 				case "a":
-					value621419e75af949d4, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen6d8595f7aef9bf39[0], 0, "a"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valued405ff4b5999a86f, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					t.A = value621419e75af949d4
+					t.A = valued405ff4b5999a86f
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -5307,19 +8446,44 @@ func (t *TestStruct) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					objde7244b4e23c4682--
+					obj1cd44835e7a1a1b6--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (u *URL) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := u.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (u *URL) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := u.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (u *URL) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("path", u.Path)
 
@@ -5332,15 +8496,36 @@ func (u *URL) MarshalJSON() ([]byte, error) {
 	enc.EncodeKeyString("domain", u.Domain)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (u *URL) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := u.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (u *URL) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -5351,55 +8536,84 @@ func (u *URL) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj82c4efae72885b92 := 1; obj82c4efae72885b92 > 0; {
-				key5f31a1d261dd028b, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seen7937d85364d6c23d [1]uint64
+			for obj52f3259b452909b5 := 1; obj52f3259b452909b5 > 0; {
+				keyeb4f14e0d9fcee91, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key5f31a1d261dd028b {
+				switch keyeb4f14e0d9fcee91 {
 				case "path":
-					valueff23f42636290f42, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen7937d85364d6c23d[0], 0, "path"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value84df5994fdc11f04, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					u.Path = valueff23f42636290f42
+					u.Path = value84df5994fdc11f04
 
 				case "query":
-					valued0073ccf8ef25b4a, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen7937d85364d6c23d[0], 1, "query"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value5c025c8d561adb0e, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					u.Query = valued0073ccf8ef25b4a
+					u.Query = value5c025c8d561adb0e
 
 				case "full":
-					value33f92417d7c203b2, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen7937d85364d6c23d[0], 2, "full"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value7dfd4748fd4b20f8, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					u.Full = value33f92417d7c203b2
+					u.Full = value7dfd4748fd4b20f8
 
 				case "scheme":
-					value6aaabfa5c070e1a3, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen7937d85364d6c23d[0], 3, "scheme"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					value4e53322471a410cd, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					u.Scheme = value6aaabfa5c070e1a3
+					u.Scheme = value4e53322471a410cd
 
 				case "domain":
-					value94369385001b4d68, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seen7937d85364d6c23d[0], 4, "domain"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valueb3fd88e48b2e7eb7, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					u.Domain = value94369385001b4d68
+					u.Domain = valueb3fd88e48b2e7eb7
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -5407,32 +8621,78 @@ func (u *URL) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj82c4efae72885b92--
+					obj52f3259b452909b5--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
 
 func (u *UserAgent) MarshalJSON() ([]byte, error) {
 	enc := backend.NewEncoder()
+	if err := u.EncodeTo(enc); err != nil {
+		enc.Release()
+		return nil, err
+	}
+
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+	enc.Release()
+
+	return data, nil
+}
+
+func (u *UserAgent) AppendJSON(dst []byte) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	if err := u.EncodeTo(enc); err != nil {
+		enc.Release()
+		return dst, err
+	}
+
+	dst = enc.Bytes()
+	enc.Release()
+
+	return dst, nil
+}
 
+func (u *UserAgent) EncodeTo(enc *backend.Encoder) error {
 	enc.WriteByte('{')
 	enc.EncodeKeyString("original", u.Original)
 
 	enc.WriteByte('}')
-	data := enc.Bytes()
-	enc.Release()
 
-	return data, nil
+	return nil
 }
 
 func (u *UserAgent) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
 	dec := backend.NewDecoder()
-	dec.SetUnsafeData(data)
+	if err := dec.CheckLength(len(data)); err != nil {
+		dec.Release()
+		return err
+	}
+
+	dec.SetData(data)
+
+	err := u.DecodeFrom(dec)
+	if err == nil {
+		err = dec.CheckEnd()
+	}
+	dec.Release()
+
+	return err
+}
+
+func (u *UserAgent) DecodeFrom(dec *backend.Decoder) error {
+	if err := dec.Enter(); err != nil {
+		return err
+	}
 
 	if char := dec.NextChar(); char == 'n' {
 		if err := dec.AssetNull(); err != nil {
@@ -5443,23 +8703,28 @@ func (u *UserAgent) UnmarshalJSON(data []byte) error {
 		return errors.NewParseError(dec.Char(), dec.Cursor())
 	} else {
 		dec.Next()
-		if dec.IsObjectClose() {
-			return nil
-		} else {
-			for obj27ef29984b01e0cb := 1; obj27ef29984b01e0cb > 0; {
-				key74633a90a7ab6c9b, err := dec.NextKey()
+		if !dec.IsObjectClose() {
+			var seeneaf21cf9005db560 [1]uint64
+			for objae5dae994cb5eae3 := 1; objae5dae994cb5eae3 > 0; {
+				keyd6d22e4d9b97d7e9, err := dec.NextKey()
 				if err != nil {
 					return err
 				}
 
-				switch key74633a90a7ab6c9b {
+				switch keyd6d22e4d9b97d7e9 {
 				case "original":
-					value2fc204f328a62ec7, err := dec.DecodeString()
+					if dup, err := dec.Seen(&seeneaf21cf9005db560[0], 0, "original"); err != nil {
+						return err
+					} else if dup {
+						break
+					}
+
+					valuee488751afcd72aa1, err := dec.DecodeString()
 					if err != nil {
 						return err
 					}
 
-					u.Original = value2fc204f328a62ec7
+					u.Original = valuee488751afcd72aa1
 
 				default:
 					if err := dec.SkipValue(); err != nil {
@@ -5467,12 +8732,12 @@ func (u *UserAgent) UnmarshalJSON(data []byte) error {
 					}
 				}
 				if dec.IsObjectClose() {
-					obj27ef29984b01e0cb--
+					objae5dae994cb5eae3--
 				}
 			}
 		}
 	}
-	dec.Release()
+	dec.Leave()
 
 	return nil
 }
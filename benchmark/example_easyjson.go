@@ -4,7 +4,6 @@ package benchmark
 
 import (
 	json "encoding/json"
-
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -29,16 +28,15 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark(in *jlexer.Lexer, out
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "original":
-			out.Original = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Original = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -81,24 +79,39 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark1(in *jlexer.Lexer, out
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "path":
-			out.Path = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Path = string(in.String())
+			}
 		case "query":
-			out.Query = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Query = string(in.String())
+			}
 		case "full":
-			out.Full = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Full = string(in.String())
+			}
 		case "scheme":
-			out.Scheme = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Scheme = string(in.String())
+			}
 		case "domain":
-			out.Domain = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Domain = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -161,16 +174,15 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark2(in *jlexer.Lexer, out
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "a":
-			out.A = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.A = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -213,52 +225,119 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark3(in *jlexer.Lexer, out
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "@timestamp":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Timestamp).UnmarshalJSON(data))
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Timestamp).UnmarshalJSON(data))
+				}
 			}
 		case "@metadata":
-			(out.Metadata).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Metadata).UnmarshalEasyJSON(in)
+			}
 		case "ecs":
-			(out.Ecs).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Ecs).UnmarshalEasyJSON(in)
+			}
 		case "host":
-			(out.Host).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Host).UnmarshalEasyJSON(in)
+			}
 		case "server":
-			(out.Server).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Server).UnmarshalEasyJSON(in)
+			}
 		case "status":
-			out.Status = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = string(in.String())
+			}
 		case "source":
-			(out.Source).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Source).UnmarshalEasyJSON(in)
+			}
 		case "method":
-			out.Method = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Method = string(in.String())
+			}
 		case "http":
-			(out.HTTP).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.HTTP).UnmarshalEasyJSON(in)
+			}
 		case "network":
-			(out.Network).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Network).UnmarshalEasyJSON(in)
+			}
 		case "url":
-			(out.URL).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.URL).UnmarshalEasyJSON(in)
+			}
 		case "client":
-			(out.Client).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Client).UnmarshalEasyJSON(in)
+			}
 		case "event":
-			(out.Event).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Event).UnmarshalEasyJSON(in)
+			}
 		case "query":
-			out.Query = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Query = string(in.String())
+			}
 		case "user_agent":
-			(out.UserAgent).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.UserAgent).UnmarshalEasyJSON(in)
+			}
 		case "destination":
-			(out.Destination).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Destination).UnmarshalEasyJSON(in)
+			}
 		case "type":
-			out.Type = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
 		case "agent":
-			(out.Agent).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Agent).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -386,20 +465,27 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark4(in *jlexer.Lexer, out
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "bytes":
-			out.Bytes = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Bytes = int(in.Int())
+			}
 		case "ip":
-			out.IP = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IP = string(in.String())
+			}
 		case "port":
-			out.Port = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Port = int(in.Int())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -452,32 +538,63 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark5(in *jlexer.Lexer, out
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "st":
-			out.St = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.St = int(in.Int())
+			}
 		case "sid":
-			out.Sid = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Sid = int(in.Int())
+			}
 		case "tt":
-			out.Tt = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Tt = string(in.String())
+			}
 		case "gr":
-			out.Gr = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Gr = int(in.Int())
+			}
 		case "uuid":
-			out.Uuid = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Uuid = string(in.String())
+			}
 		case "ip":
-			out.Ip = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Ip = string(in.String())
+			}
 		case "ua":
-			out.Ua = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Ua = string(in.String())
+			}
 		case "tz":
-			out.Tz = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Tz = int(in.Int())
+			}
 		case "v":
-			out.V = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.V = int(in.Int())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -560,22 +677,33 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark6(in *jlexer.Lexer, out
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "ip":
-			out.IP = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IP = string(in.String())
+			}
 		case "port":
-			out.Port = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Port = int(in.Int())
+			}
 		case "domain":
-			out.Domain = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Domain = string(in.String())
+			}
 		case "bytes":
-			out.Bytes = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Bytes = int(in.Int())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -633,28 +761,51 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark7(in *jlexer.Lexer, out
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "content-length":
-			out.ContentLength = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ContentLength = int(in.Int())
+			}
 		case "transfer-encoding":
-			out.TransferEncoding = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransferEncoding = string(in.String())
+			}
 		case "connection":
-			out.Connection = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Connection = string(in.String())
+			}
 		case "cache-control":
-			out.CacheControl = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CacheControl = string(in.String())
+			}
 		case "pragma":
-			out.Pragma = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Pragma = string(in.String())
+			}
 		case "server":
-			out.Server = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Server = string(in.String())
+			}
 		case "date":
-			out.Date = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Date = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -716,66 +867,7 @@ func (v ResponseHeaders) MarshalEasyJSON(w *jwriter.Writer) {
 func (v *ResponseHeaders) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark7(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark8(in *jlexer.Lexer, out *Body) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "content":
-			out.Content = string(in.String())
-		case "bytes":
-			out.Bytes = int(in.Int())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark8(out *jwriter.Writer, in Body) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"content\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Content))
-	}
-	{
-		const prefix string = ",\"bytes\":"
-		out.RawString(prefix)
-		out.Int(int(in.Bytes))
-	}
-	out.RawByte('}')
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Body) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark8(w, v)
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Body) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark8(l, v)
-}
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark9(in *jlexer.Lexer, out *Response) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark8(in *jlexer.Lexer, out *Response) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -786,24 +878,39 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark9(in *jlexer.Lexer, out
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "status_code":
-			out.StatusCode = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StatusCode = int(in.Int())
+			}
 		case "body":
-			(out.Body).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Body).UnmarshalEasyJSON(in)
+			}
 		case "bytes":
-			out.Bytes = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Bytes = int(in.Int())
+			}
 		case "headers":
-			(out.Headers).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Headers).UnmarshalEasyJSON(in)
+			}
 		case "status_phrase":
-			out.StatusPhrase = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StatusPhrase = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -814,7 +921,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark9(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark9(out *jwriter.Writer, in Response) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark8(out *jwriter.Writer, in Response) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -848,14 +955,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark9(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Response) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark9(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark8(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Response) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark9(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark8(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark10(in *jlexer.Lexer, out *RequestHeaders) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark9(in *jlexer.Lexer, out *RequestHeaders) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -866,40 +973,87 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark10(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "referer":
-			out.Referer = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Referer = string(in.String())
+			}
 		case "x-requested-with":
-			out.XRequestedWith = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.XRequestedWith = string(in.String())
+			}
 		case "yz_client_ip":
-			out.YzClientIP = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.YzClientIP = string(in.String())
+			}
 		case "user-agent":
-			out.UserAgent = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UserAgent = string(in.String())
+			}
 		case "accept-language":
-			out.AcceptLanguage = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AcceptLanguage = string(in.String())
+			}
 		case "content-length":
-			out.ContentLength = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ContentLength = int(in.Int())
+			}
 		case "x-real-ip":
-			out.XRealIP = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.XRealIP = string(in.String())
+			}
 		case "pragma":
-			out.Pragma = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Pragma = string(in.String())
+			}
 		case "connection":
-			out.Connection = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Connection = string(in.String())
+			}
 		case "accept":
-			out.Accept = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Accept = string(in.String())
+			}
 		case "host":
-			out.Host = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Host = string(in.String())
+			}
 		case "x-forwarded-for":
-			out.XForwardedFor = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.XForwardedFor = string(in.String())
+			}
 		case "content-type":
-			out.ContentType = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ContentType = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -910,7 +1064,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark10(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark10(out *jwriter.Writer, in RequestHeaders) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark9(out *jwriter.Writer, in RequestHeaders) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -984,64 +1138,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark10(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v RequestHeaders) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark10(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark9(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *RequestHeaders) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark10(l, v)
-}
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark11(in *jlexer.Lexer, out *Body) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "content":
-			out.Content = string(in.String())
-		case "bytes":
-			out.Bytes = int(in.Int())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark11(out *jwriter.Writer, in Body) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"content\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Content))
-	}
-	{
-		const prefix string = ",\"bytes\":"
-		out.RawString(prefix)
-		out.Int(int(in.Bytes))
-	}
-	out.RawByte('}')
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark9(l, v)
 }
-
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark12(in *jlexer.Lexer, out *Request) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark10(in *jlexer.Lexer, out *Request) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1052,22 +1156,39 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark12(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "referrer":
-			out.Referrer = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Referrer = string(in.String())
+			}
 		case "bytes":
-			out.Bytes = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Bytes = int(in.Int())
+			}
 		case "headers":
-			(out.Headers).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Headers).UnmarshalEasyJSON(in)
+			}
 		case "method":
-			out.Method = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Method = string(in.String())
+			}
+		case "body":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Body).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1078,7 +1199,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark12(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark12(out *jwriter.Writer, in Request) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark10(out *jwriter.Writer, in Request) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1102,19 +1223,24 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark12(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.String(string(in.Method))
 	}
+	{
+		const prefix string = ",\"body\":"
+		out.RawString(prefix)
+		(in.Body).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Request) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark12(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark10(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Request) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark12(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark10(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark13(in *jlexer.Lexer, out *Network) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark11(in *jlexer.Lexer, out *Network) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1125,24 +1251,39 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark13(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "type":
-			out.Type = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
 		case "transport":
-			out.Transport = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Transport = string(in.String())
+			}
 		case "protocol":
-			out.Protocol = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Protocol = string(in.String())
+			}
 		case "community_id":
-			out.CommunityID = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CommunityID = string(in.String())
+			}
 		case "bytes":
-			out.Bytes = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Bytes = int(in.Int())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1153,7 +1294,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark13(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark13(out *jwriter.Writer, in Network) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark11(out *jwriter.Writer, in Network) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1187,14 +1328,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark13(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Network) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark13(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark11(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Network) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark13(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark11(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark14(in *jlexer.Lexer, out *Metadata) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark12(in *jlexer.Lexer, out *Metadata) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1205,22 +1346,33 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark14(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "beat":
-			out.Beat = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Beat = string(in.String())
+			}
 		case "type":
-			out.Type = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
 		case "version":
-			out.Version = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Version = string(in.String())
+			}
 		case "topic":
-			out.Topic = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Topic = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1231,7 +1383,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark14(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark14(out *jwriter.Writer, in Metadata) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark12(out *jwriter.Writer, in Metadata) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1260,14 +1412,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark14(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Metadata) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark14(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark12(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Metadata) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark14(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark12(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark15(in *jlexer.Lexer, out *MediumPayload) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark13(in *jlexer.Lexer, out *MediumPayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1278,13 +1430,8 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark15(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "person":
 			if in.IsNull() {
@@ -1294,10 +1441,18 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark15(in *jlexer.Lexer, ou
 				if out.Person == nil {
 					out.Person = new(CBPerson)
 				}
-				(*out.Person).UnmarshalEasyJSON(in)
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Person).UnmarshalEasyJSON(in)
+				}
 			}
 		case "company":
-			out.Company = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Company = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1308,7 +1463,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark15(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark15(out *jwriter.Writer, in MediumPayload) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark13(out *jwriter.Writer, in MediumPayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1333,14 +1488,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark15(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MediumPayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark15(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark13(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MediumPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark15(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark13(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark16(in *jlexer.Lexer, out *LargePayload) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark14(in *jlexer.Lexer, out *LargePayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1351,13 +1506,8 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark16(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "users":
 			if in.IsNull() {
@@ -1383,7 +1533,11 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark16(in *jlexer.Lexer, ou
 						if v1 == nil {
 							v1 = new(DSUser)
 						}
-						(*v1).UnmarshalEasyJSON(in)
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v1).UnmarshalEasyJSON(in)
+						}
 					}
 					out.Users = append(out.Users, v1)
 					in.WantComma()
@@ -1398,7 +1552,11 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark16(in *jlexer.Lexer, ou
 				if out.Topics == nil {
 					out.Topics = new(DSTopicsList)
 				}
-				(*out.Topics).UnmarshalEasyJSON(in)
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Topics).UnmarshalEasyJSON(in)
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -1410,7 +1568,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark16(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark16(out *jwriter.Writer, in LargePayload) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark14(out *jwriter.Writer, in LargePayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1444,14 +1602,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark16(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LargePayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark16(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark14(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LargePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark16(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark14(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark17(in *jlexer.Lexer, out *Host) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark15(in *jlexer.Lexer, out *Host) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1462,16 +1620,15 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark17(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "name":
-			out.Name = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1482,7 +1639,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark17(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark17(out *jwriter.Writer, in Host) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark15(out *jwriter.Writer, in Host) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1496,14 +1653,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark17(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Host) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark17(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark15(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Host) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark17(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark15(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark18(in *jlexer.Lexer, out *HTTP) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark16(in *jlexer.Lexer, out *HTTP) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1514,20 +1671,27 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark18(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "response":
-			(out.Response).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Response).UnmarshalEasyJSON(in)
+			}
 		case "version":
-			out.Version = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Version = string(in.String())
+			}
 		case "request":
-			(out.Request).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Request).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1538,7 +1702,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark18(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark18(out *jwriter.Writer, in HTTP) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark16(out *jwriter.Writer, in HTTP) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1562,14 +1726,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark18(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HTTP) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark18(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark16(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HTTP) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark18(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark16(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark19(in *jlexer.Lexer, out *Event) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark17(in *jlexer.Lexer, out *Event) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1580,30 +1744,49 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark19(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "duration":
-			out.Duration = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Duration = int(in.Int())
+			}
 		case "start":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Start).UnmarshalJSON(data))
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Start).UnmarshalJSON(data))
+				}
 			}
 		case "end":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.End).UnmarshalJSON(data))
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.End).UnmarshalJSON(data))
+				}
 			}
 		case "kind":
-			out.Kind = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
 		case "category":
-			out.Category = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Category = string(in.String())
+			}
 		case "dataset":
-			out.Dataset = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Dataset = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1614,7 +1797,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark19(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark19(out *jwriter.Writer, in Event) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark17(out *jwriter.Writer, in Event) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1653,14 +1836,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark19(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Event) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark19(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark17(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Event) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark19(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark17(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark20(in *jlexer.Lexer, out *Ecs) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark18(in *jlexer.Lexer, out *Ecs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1671,16 +1854,15 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark20(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "version":
-			out.Version = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Version = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1691,7 +1873,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark20(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark20(out *jwriter.Writer, in Ecs) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark18(out *jwriter.Writer, in Ecs) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1705,14 +1887,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark20(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Ecs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark20(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark18(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Ecs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark20(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark18(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark21(in *jlexer.Lexer, out *Destination) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark19(in *jlexer.Lexer, out *Destination) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1723,22 +1905,33 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark21(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "ip":
-			out.IP = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IP = string(in.String())
+			}
 		case "port":
-			out.Port = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Port = int(in.Int())
+			}
 		case "domain":
-			out.Domain = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Domain = string(in.String())
+			}
 		case "bytes":
-			out.Bytes = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Bytes = int(in.Int())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1749,7 +1942,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark21(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark21(out *jwriter.Writer, in Destination) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark19(out *jwriter.Writer, in Destination) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1778,14 +1971,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark21(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Destination) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark21(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark19(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Destination) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark21(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark19(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark22(in *jlexer.Lexer, out *DSUser) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark20(in *jlexer.Lexer, out *DSUser) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1796,16 +1989,15 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark22(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "username":
-			out.Username = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Username = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1816,7 +2008,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark22(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark22(out *jwriter.Writer, in DSUser) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark20(out *jwriter.Writer, in DSUser) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1830,14 +2022,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark22(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DSUser) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark22(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark20(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DSUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark22(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark20(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark23(in *jlexer.Lexer, out *DSTopicsList) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark21(in *jlexer.Lexer, out *DSTopicsList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1848,13 +2040,8 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark23(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "topics":
 			if in.IsNull() {
@@ -1880,7 +2067,11 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark23(in *jlexer.Lexer, ou
 						if v4 == nil {
 							v4 = new(DSTopic)
 						}
-						(*v4).UnmarshalEasyJSON(in)
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v4).UnmarshalEasyJSON(in)
+						}
 					}
 					out.Topics = append(out.Topics, v4)
 					in.WantComma()
@@ -1888,7 +2079,11 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark23(in *jlexer.Lexer, ou
 				in.Delim(']')
 			}
 		case "more_topics_url":
-			out.MoreTopicsUrl = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MoreTopicsUrl = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1899,7 +2094,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark23(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark23(out *jwriter.Writer, in DSTopicsList) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark21(out *jwriter.Writer, in DSTopicsList) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1933,14 +2128,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark23(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DSTopicsList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark23(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark21(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DSTopicsList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark23(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark21(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark24(in *jlexer.Lexer, out *DSTopic) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark22(in *jlexer.Lexer, out *DSTopic) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1951,18 +2146,21 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark24(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "id":
-			out.Id = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Id = int(in.Int())
+			}
 		case "slug":
-			out.Slug = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Slug = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1973,7 +2171,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark24(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark24(out *jwriter.Writer, in DSTopic) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark22(out *jwriter.Writer, in DSTopic) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1992,14 +2190,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark24(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DSTopic) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark24(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark22(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DSTopic) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark24(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark22(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark25(in *jlexer.Lexer, out *Client) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark23(in *jlexer.Lexer, out *Client) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2010,20 +2208,27 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark25(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "bytes":
-			out.Bytes = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Bytes = int(in.Int())
+			}
 		case "ip":
-			out.IP = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IP = string(in.String())
+			}
 		case "port":
-			out.Port = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Port = int(in.Int())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2034,7 +2239,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark25(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark25(out *jwriter.Writer, in Client) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark23(out *jwriter.Writer, in Client) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2058,14 +2263,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark25(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Client) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark25(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark23(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Client) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark25(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark23(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark26(in *jlexer.Lexer, out *CBPerson) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark24(in *jlexer.Lexer, out *CBPerson) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2076,13 +2281,8 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark26(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "name":
 			if in.IsNull() {
@@ -2092,7 +2292,11 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark26(in *jlexer.Lexer, ou
 				if out.Name == nil {
 					out.Name = new(CBName)
 				}
-				(*out.Name).UnmarshalEasyJSON(in)
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Name).UnmarshalEasyJSON(in)
+				}
 			}
 		case "github":
 			if in.IsNull() {
@@ -2102,7 +2306,11 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark26(in *jlexer.Lexer, ou
 				if out.Github == nil {
 					out.Github = new(CBGithub)
 				}
-				(*out.Github).UnmarshalEasyJSON(in)
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Github).UnmarshalEasyJSON(in)
+				}
 			}
 		case "gravatar":
 			if in.IsNull() {
@@ -2112,7 +2320,11 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark26(in *jlexer.Lexer, ou
 				if out.Gravatar == nil {
 					out.Gravatar = new(CBGravatar)
 				}
-				(*out.Gravatar).UnmarshalEasyJSON(in)
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Gravatar).UnmarshalEasyJSON(in)
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -2124,7 +2336,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark26(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark26(out *jwriter.Writer, in CBPerson) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark24(out *jwriter.Writer, in CBPerson) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2160,14 +2372,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark26(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CBPerson) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark26(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark24(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CBPerson) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark26(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark24(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark27(in *jlexer.Lexer, out *CBName) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark25(in *jlexer.Lexer, out *CBName) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2178,16 +2390,15 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark27(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "fullName":
-			out.FullName = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FullName = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2198,7 +2409,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark27(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark27(out *jwriter.Writer, in CBName) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark25(out *jwriter.Writer, in CBName) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2212,14 +2423,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark27(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CBName) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark27(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark25(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CBName) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark27(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark25(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark28(in *jlexer.Lexer, out *CBGravatar) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark26(in *jlexer.Lexer, out *CBGravatar) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2230,13 +2441,8 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark28(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "avatars":
 			if in.IsNull() {
@@ -2262,7 +2468,11 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark28(in *jlexer.Lexer, ou
 						if v7 == nil {
 							v7 = new(CBAvatar)
 						}
-						(*v7).UnmarshalEasyJSON(in)
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v7).UnmarshalEasyJSON(in)
+						}
 					}
 					out.Avatars = append(out.Avatars, v7)
 					in.WantComma()
@@ -2279,7 +2489,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark28(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark28(out *jwriter.Writer, in CBGravatar) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark26(out *jwriter.Writer, in CBGravatar) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2308,14 +2518,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark28(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CBGravatar) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark28(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark26(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CBGravatar) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark28(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark26(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark29(in *jlexer.Lexer, out *CBGithub) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark27(in *jlexer.Lexer, out *CBGithub) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2326,16 +2536,15 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark29(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "followers":
-			out.Followers = int(in.Int())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Followers = int(in.Int())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2346,7 +2555,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark29(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark29(out *jwriter.Writer, in CBGithub) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark27(out *jwriter.Writer, in CBGithub) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2360,14 +2569,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark29(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CBGithub) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark29(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark27(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CBGithub) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark29(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark27(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark30(in *jlexer.Lexer, out *CBAvatar) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark28(in *jlexer.Lexer, out *CBAvatar) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2378,16 +2587,15 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark30(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
 		switch key {
 		case "url":
-			out.Url = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Url = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2398,7 +2606,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark30(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark30(out *jwriter.Writer, in CBAvatar) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark28(out *jwriter.Writer, in CBAvatar) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2412,14 +2620,14 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark30(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CBAvatar) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark30(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark28(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CBAvatar) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark30(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark28(l, v)
 }
-func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark31(in *jlexer.Lexer, out *Agent) {
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark29(in *jlexer.Lexer, out *Body) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2430,24 +2638,101 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark31(in *jlexer.Lexer, ou
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
+		switch key {
+		case "content":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Content = string(in.String())
+			}
+		case "bytes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Bytes = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark29(out *jwriter.Writer, in Body) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"bytes\":"
+		out.RawString(prefix)
+		out.Int(int(in.Bytes))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Body) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark29(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Body) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark29(l, v)
+}
+func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark30(in *jlexer.Lexer, out *Agent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
 		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
 		switch key {
 		case "hostname":
-			out.Hostname = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Hostname = string(in.String())
+			}
 		case "id":
-			out.ID = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = string(in.String())
+			}
 		case "version":
-			out.Version = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Version = string(in.String())
+			}
 		case "type":
-			out.Type = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
 		case "ephemeral_id":
-			out.EphemeralID = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EphemeralID = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2458,7 +2743,7 @@ func easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark31(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark31(out *jwriter.Writer, in Agent) {
+func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark30(out *jwriter.Writer, in Agent) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2492,10 +2777,10 @@ func easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark31(out *jwriter.Writer,
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Agent) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark31(w, v)
+	easyjsonEeca4a30EncodeGithubComGoFishGojsonBenchmark30(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Agent) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark31(l, v)
+	easyjsonEeca4a30DecodeGithubComGoFishGojsonBenchmark30(l, v)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/buger/jsonparser"
 	"github.com/francoispqt/gojay"
 	"github.com/go-fish/gojson"
+	"github.com/go-fish/gojson/backend"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
@@ -145,6 +146,63 @@ func BenchmarkJsonParserUnmarshalLarge(b *testing.B) {
 	}
 }
 
+func BenchmarkGOJSONEachLarge(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(largeFixture)))
+	b.ResetTimer()
+
+	dec := backend.NewDecoder()
+	defer dec.Release()
+
+	for i := 0; i < b.N; i++ {
+		dec.Reset(largeFixture, backend.WithUnsafe())
+
+		dec.ObjectEach(func(key []byte, dec *backend.Decoder) error {
+			switch string(key) {
+			case "users":
+				return dec.ArrayEach(func(dec *backend.Decoder, index int) error {
+					return dec.ObjectEach(func(key []byte, dec *backend.Decoder) error {
+						if string(key) == "username" {
+							username, err := dec.DecodeString()
+							nothing(username)
+							return err
+						}
+
+						return nil
+					})
+				})
+
+			case "topics":
+				return dec.ObjectEach(func(key []byte, dec *backend.Decoder) error {
+					if string(key) != "topics" {
+						return nil
+					}
+
+					return dec.ArrayEach(func(dec *backend.Decoder, index int) error {
+						return dec.ObjectEach(func(key []byte, dec *backend.Decoder) error {
+							switch string(key) {
+							case "id":
+								id, err := dec.DecodeInt()
+								nothing(id)
+								return err
+
+							case "slug":
+								slug, err := dec.DecodeString()
+								nothing(slug)
+								return err
+							}
+
+							return nil
+						})
+					})
+				})
+			}
+
+			return nil
+		})
+	}
+}
+
 func BenchmarkGoJayUnmarshalLarge(b *testing.B) {
 	b.ReportAllocs()
 	b.SetBytes(int64(len(largeFixture)))
@@ -272,6 +330,30 @@ func BenchmarkJsonParserUnmarshalMedium(b *testing.B) {
 	}
 }
 
+func BenchmarkGOJSONGetMedium(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(mediumFixture)))
+	b.ResetTimer()
+
+	dec := backend.NewDecoder()
+	defer dec.Release()
+
+	for i := 0; i < b.N; i++ {
+		gojson.Get(mediumFixture, "person", "name", "fullName")
+		gojson.GetInt64(mediumFixture, "person", "github", "followers")
+		gojson.Get(mediumFixture, "company")
+
+		dec.Reset(mediumFixture, backend.WithUnsafe())
+		if err := dec.Seek("person", "gravatar", "avatars"); err != nil {
+			b.Fatal(err)
+		}
+
+		dec.ArrayEach(func(dec *backend.Decoder, index int) error {
+			return dec.Seek("url")
+		})
+	}
+}
+
 func BenchmarkGoJayUnmarshalMedium(b *testing.B) {
 	b.ReportAllocs()
 	b.SetBytes(int64(len(mediumFixture)))
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/go-fish/gojson"
+)
+
+// diff runs `gojson diff [-tolerance n] [-sets] <a> <b>`, it prints the changes which turn a into b, one per line,
+// and reports whether the documents differ.
+func diff(args []string) (bool, error) {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Printf("Usage: %s diff [options] <a.json> <b.json>\n\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+
+	tolerance := flags.Float64("tolerance", 0, "Consider numbers equal if they differ by at most this value")
+	sets := flags.Bool("sets", false, "Ignore the order of the elements of arrays")
+	flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	a, err := ioutil.ReadFile(flags.Arg(0))
+	if err != nil {
+		return false, err
+	}
+
+	b, err := ioutil.ReadFile(flags.Arg(1))
+	if err != nil {
+		return false, err
+	}
+
+	opts := []gojson.DiffOption{gojson.WithTolerance(*tolerance)}
+	if *sets {
+		opts = append(opts, gojson.WithArraysAsSets())
+	}
+
+	changes, err := gojson.Diff(a, b, opts...)
+	if err != nil {
+		return false, err
+	}
+
+	var buf bytes.Buffer
+	for _, c := range changes {
+		path := c.Path
+		if path == "" {
+			path = "(root)"
+		}
+
+		switch c.Type {
+		case gojson.Added:
+			buf.WriteString("+ " + path + ": ")
+			err = json.Compact(&buf, c.New)
+
+		case gojson.Removed:
+			buf.WriteString("- " + path + ": ")
+			err = json.Compact(&buf, c.Old)
+
+		case gojson.Changed:
+			buf.WriteString("~ " + path + ": ")
+			if err = json.Compact(&buf, c.Old); err == nil {
+				buf.WriteString(" -> ")
+				err = json.Compact(&buf, c.New)
+			}
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		buf.WriteByte('\n')
+	}
+
+	if _, err := os.Stdout.Write(buf.Bytes()); err != nil {
+		return false, err
+	}
+
+	return len(changes) > 0, nil
+}
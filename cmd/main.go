@@ -4,7 +4,9 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/go-fish/gojson/gen"
 	"github.com/go-fish/gojson/option"
@@ -12,23 +14,31 @@ import (
 	"github.com/ttacon/chalk"
 )
 
-func parseOption() (*option.Option, error) {
+func parseOption() ([]*option.Option, error) {
 	opt, err := option.NewOption()
 	if err != nil {
 		return nil, err
 	}
 
 	flag.Usage = func() {
-		fmt.Printf("Usage: %s [options] <input dir|file>\n\n", os.Args[0])
+		fmt.Printf("Usage: %s [options] <input dir|file|pattern>...\n", os.Args[0])
+		fmt.Printf("       %s query [options] <jsonpath> [file]\n", os.Args[0])
+		fmt.Printf("       %s diff [options] <a.json> <b.json>\n\n", os.Args[0])
 		flag.PrintDefaults()
 	}
 
-	var mode string
+	var mode, number, typ string
 
-	flag.StringVar(&opt.Output, "o", opt.Output, "Optional name of the output file to be generated.")
+	flag.StringVar(&opt.Output, "o", opt.Output, "Optional name of the output file to be generated in each package, {dir} and {pkg} are replaced with the directory and name of package.")
 	flag.StringVar(&mode, "m", "all", "Mode of generate, eg: encode, decode, all")
+	flag.StringVar(&opt.Naming, "naming", "", "Naming of keys for fields without json tags, eg: snake, camel, kebab, lower")
 	flag.BoolVar(&opt.Unsafe, "unsafe", false, "Use decoder without copy data")
 	flag.BoolVar(&opt.Inline, "inline", true, "Use inline function in generate code")
+	flag.BoolVar(&opt.Enum, "enum", false, "Encode named integer types with a String method as their names")
+	flag.StringVar(&typ, "type", "", "Comma-separated list of types to generate, eg: User,Order")
+	flag.StringVar(&opt.Tags, "tags", "", "Comma-separated list of build tags used to load the package")
+	flag.StringVar(&number, "number", "float64", "Type of numbers decoded into interface{} values, eg: float64, number, int64")
+	flag.BoolVar(&opt.Check, "check", false, "Check whether the output file is up to date instead of generating it")
 	ver := flag.Bool("version", false, "Show version information.")
 
 	flag.Parse()
@@ -40,47 +50,127 @@ func parseOption() (*option.Option, error) {
 
 	switch strings.ToLower(mode) {
 	case "encode":
-		opt.Mode |= option.Encode
+		opt.Mode = option.Encode
 
 	case "decode":
-		opt.Mode |= option.Decode
+		opt.Mode = option.Decode
 
 	case "all":
-		opt.Mode |= option.All
+		opt.Mode = option.All
+	}
+
+	if typ != "" {
+		for _, t := range strings.Split(typ, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				opt.Types = append(opt.Types, t)
+			}
+		}
+	}
+
+	switch strings.ToLower(number) {
+	case "float64":
+		opt.Number = option.Float64Number
+
+	case "number":
+		opt.Number = option.UseNumber
+
+	case "int64":
+		opt.Number = option.UseInt64
+
+	default:
+		return nil, fmt.Errorf("Unsupported number type %s", number)
 	}
 
 	if flag.NArg() == 0 {
-		fmt.Fprintf(os.Stderr, chalk.Red.Color("Missing <input dir|file>, need exactly one\n"))
+		fmt.Fprint(os.Stderr, chalk.Red.Color("Missing <input dir|file|pattern>, need at least one\n"))
 		flag.Usage()
 		os.Exit(1)
 	}
 
-	if flag.NArg() > 1 {
-		fmt.Fprintf(os.Stderr, chalk.Red.Color("Too many <input dir|file>, need exactly one\n"))
-		flag.Usage()
+	// parse pkgs of inputs
+	return opt.ParsePackages(flag.Args()...)
+}
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "query":
+			if err := query(os.Args[2:]); err != nil {
+				fmt.Fprint(os.Stderr, chalk.Red.Color(fmt.Sprintf("gojson error: %s\n", err)))
+				os.Exit(1)
+			}
+
+			return
+
+		case "diff":
+			// like diff(1), the status is 1 if the documents differ and 2 on errors
+			changed, err := diff(os.Args[2:])
+			if err != nil {
+				fmt.Fprint(os.Stderr, chalk.Red.Color(fmt.Sprintf("gojson error: %s\n", err)))
+				os.Exit(2)
+			}
+
+			if changed {
+				os.Exit(1)
+			}
+
+			return
+		}
+	}
+
+	opts, err := parseOption()
+	if err != nil {
+		fmt.Fprint(os.Stderr, chalk.Red.Color(fmt.Sprintf("gojson error: %s\n", err)))
 		os.Exit(1)
 	}
 
-	// append input files to option
-	opt.Input = flag.Arg(0)
+	generate := gen.Generate
+	if len(opts) > 0 && opts[0].Check {
+		generate = gen.Check
+	}
 
-	// parse pkg in opt
-	if err := opt.ParsePackage(); err != nil {
-		return nil, err
+	// generate packages in parallel
+	errs := make([]error, len(opts))
+	limit := make(chan struct{}, runtime.NumCPU())
+
+	var wg sync.WaitGroup
+	for i, opt := range opts {
+		if opt.Err != nil {
+			errs[i] = opt.Err
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, opt *option.Option) {
+			defer wg.Done()
+
+			limit <- struct{}{}
+			errs[i] = generate(opt)
+			<-limit
+		}(i, opt)
 	}
 
-	return opt, nil
-}
+	wg.Wait()
 
-func main() {
-	opt, err := parseOption()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, chalk.Red.Color(fmt.Sprintf("gojson error: %s\n", err)))
-		os.Exit(1)
+	failed := 0
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		failed++
+		if len(opts) > 1 {
+			err = fmt.Errorf("%s: %s", opts[i].Input, err)
+		}
+
+		fmt.Fprint(os.Stderr, chalk.Red.Color(fmt.Sprintf("gojson error: %s\n", err)))
 	}
 
-	if err := gen.Generate(opt); err != nil {
-		fmt.Fprintf(os.Stderr, chalk.Red.Color(fmt.Sprintf("gojson error: %s\n", err)))
+	if failed > 0 {
+		if len(opts) > 1 {
+			fmt.Fprint(os.Stderr, chalk.Red.Color(fmt.Sprintf("gojson error: %d of %d packages failed\n", failed, len(opts))))
+		}
+
 		os.Exit(1)
 	}
 }
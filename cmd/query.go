@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/go-fish/gojson/jsonpath"
+)
+
+// query runs `gojson query [-path] <jsonpath> [file]`, it prints the values selected in file, or in stdin, one per line.
+func query(args []string) error {
+	flags := flag.NewFlagSet("query", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Printf("Usage: %s query [options] <jsonpath> [file]\n\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+
+	path := flags.Bool("path", false, "Print the normalized path of each value before it")
+	flags.Parse(args)
+
+	// usage errors exit with 2 like the errors of flags
+	if flags.NArg() == 0 || flags.NArg() > 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	q, err := jsonpath.Compile(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if flags.NArg() == 2 {
+		data, err = ioutil.ReadFile(flags.Arg(1))
+	} else {
+		data, err = ioutil.ReadAll(os.Stdin)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	matches, err := q.Find(data)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for _, m := range matches {
+		if *path {
+			buf.WriteString(m.Path)
+			buf.WriteByte('\t')
+		}
+
+		if err := json.Compact(&buf, m.Value); err != nil {
+			return err
+		}
+
+		buf.WriteByte('\n')
+	}
+
+	_, err = os.Stdout.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,310 @@
+package gojson
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/go-fish/gojson/backend"
+	"github.com/go-fish/gojson/pointer"
+)
+
+// ChangeType is the type of a change reported by Diff.
+type ChangeType uint8
+
+const (
+	Added ChangeType = iota + 1
+	Removed
+	Changed
+)
+
+func (t ChangeType) String() string {
+	switch t {
+	case Added:
+		return "added"
+
+	case Removed:
+		return "removed"
+
+	case Changed:
+		return "changed"
+
+	default:
+		return "unknown"
+	}
+}
+
+// Change is a difference between two json documents. Path is the json pointer of the value, in the first document
+// for removed values and in the second one otherwise. Old is nil for added values and New is nil for removed values,
+// they alias the documents.
+type Change struct {
+	Type ChangeType
+	Path string
+	Old  []byte
+	New  []byte
+}
+
+// DiffOption configures Diff.
+type DiffOption func(d *differ)
+
+// WithTolerance makes Diff consider numbers equal if they differ by at most tolerance.
+func WithTolerance(tolerance float64) DiffOption {
+	return func(d *differ) { d.tolerance = tolerance }
+}
+
+// WithArraysAsSets makes Diff ignore the order of the elements of arrays, the elements of a which are not in b are
+// removed and the elements of b which are not in a are added.
+func WithArraysAsSets() DiffOption {
+	return func(d *differ) { d.sets = true }
+}
+
+// Diff returns the changes which turn the json document a into b, whitespace and the order of keys are ignored,
+// strings are compared unescaped and numbers by value. values of different types are changed as a whole,
+// members of objects are removed, added or diffed by key, and elements of arrays by index.
+func Diff(a, b []byte, opts ...DiffOption) ([]Change, error) {
+	if err := backend.Validate(a); err != nil {
+		return nil, err
+	}
+
+	if err := backend.Validate(b); err != nil {
+		return nil, err
+	}
+
+	d := &differ{}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	if err := d.diff(a, b); err != nil {
+		return nil, err
+	}
+
+	return d.changes, nil
+}
+
+type differ struct {
+	tolerance float64
+	sets      bool
+
+	// path holds the tokens of the current value.
+	path    []string
+	changes []Change
+}
+
+func (d *differ) add(typ ChangeType, oldValue, newValue []byte) {
+	if oldValue != nil {
+		oldValue = backend.Trim(oldValue)
+	}
+
+	if newValue != nil {
+		newValue = backend.Trim(newValue)
+	}
+
+	d.changes = append(d.changes, Change{typ, pointer.Format(d.path...), oldValue, newValue})
+}
+
+func (d *differ) push(token string) {
+	d.path = append(d.path, token)
+}
+
+func (d *differ) pop() {
+	d.path = d.path[:len(d.path)-1]
+}
+
+func (d *differ) diff(a, b []byte) error {
+	typ := backend.TypeOf(a)
+	if typ != backend.TypeOf(b) {
+		d.add(Changed, a, b)
+		return nil
+	}
+
+	switch typ {
+	case backend.ObjectType:
+		return d.diffObject(a, b)
+
+	case backend.ArrayType:
+		if d.sets {
+			return d.diffSet(a, b)
+		}
+
+		return d.diffArray(a, b)
+
+	default:
+		eq, err := d.equalScalar(typ, a, b)
+		if err != nil {
+			return err
+		}
+
+		if !eq {
+			d.add(Changed, a, b)
+		}
+
+		return nil
+	}
+}
+
+func (d *differ) diffObject(a, b []byte) error {
+	ma, err := backend.Members(a)
+	if err != nil {
+		return err
+	}
+
+	mb, err := backend.Members(b)
+	if err != nil {
+		return err
+	}
+
+	// the last value of a duplicate key wins in both documents
+	values := make(map[string][]byte, len(mb))
+	for _, m := range mb {
+		values[m.Key] = m.Value
+	}
+
+	keys := make(map[string]int, len(ma))
+	members := make([]backend.Member, 0, len(ma))
+	for _, m := range ma {
+		if i, ok := keys[m.Key]; ok {
+			members[i].Value = m.Value
+			continue
+		}
+
+		keys[m.Key] = len(members)
+		members = append(members, m)
+	}
+
+	for _, m := range members {
+		d.push(m.Key)
+
+		if value, ok := values[m.Key]; !ok {
+			d.add(Removed, m.Value, nil)
+		} else if err := d.diff(m.Value, value); err != nil {
+			return err
+		}
+
+		d.pop()
+	}
+
+	for _, m := range mb {
+		if _, ok := keys[m.Key]; !ok {
+			keys[m.Key] = -1
+
+			d.push(m.Key)
+			d.add(Added, nil, values[m.Key])
+			d.pop()
+		}
+	}
+
+	return nil
+}
+
+func (d *differ) diffArray(a, b []byte) error {
+	ea, err := backend.Elements(a)
+	if err != nil {
+		return err
+	}
+
+	eb, err := backend.Elements(b)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(ea) || i < len(eb); i++ {
+		d.push(strconv.Itoa(i))
+
+		switch {
+		case i >= len(eb):
+			d.add(Removed, ea[i], nil)
+
+		case i >= len(ea):
+			d.add(Added, nil, eb[i])
+
+		default:
+			if err := d.diff(ea[i], eb[i]); err != nil {
+				return err
+			}
+		}
+
+		d.pop()
+	}
+
+	return nil
+}
+
+// diffSet matches each element of a with the first equal element of b which is not matched yet.
+func (d *differ) diffSet(a, b []byte) error {
+	ea, err := backend.Elements(a)
+	if err != nil {
+		return err
+	}
+
+	eb, err := backend.Elements(b)
+	if err != nil {
+		return err
+	}
+
+	matched := make([]bool, len(eb))
+	for i, value := range ea {
+		found := false
+
+		for j := range eb {
+			if matched[j] {
+				continue
+			}
+
+			eq, err := d.equal(value, eb[j])
+			if err != nil {
+				return err
+			}
+
+			if eq {
+				matched[j], found = true, true
+				break
+			}
+		}
+
+		if !found {
+			d.push(strconv.Itoa(i))
+			d.add(Removed, value, nil)
+			d.pop()
+		}
+	}
+
+	for j, value := range eb {
+		if !matched[j] {
+			d.push(strconv.Itoa(j))
+			d.add(Added, nil, value)
+			d.pop()
+		}
+	}
+
+	return nil
+}
+
+// equal reports whether a and b have no changes with the options of d.
+func (d *differ) equal(a, b []byte) (bool, error) {
+	sub := &differ{tolerance: d.tolerance, sets: d.sets}
+	if err := sub.diff(a, b); err != nil {
+		return false, err
+	}
+
+	return len(sub.changes) == 0, nil
+}
+
+func (d *differ) equalScalar(typ backend.ValueType, a, b []byte) (bool, error) {
+	if typ != backend.NumberType || d.tolerance <= 0 {
+		return backend.Equal(a, b)
+	}
+
+	na, ok := new(big.Rat).SetString(string(backend.Trim(a)))
+	if !ok {
+		return backend.Equal(a, b)
+	}
+
+	nb, ok := new(big.Rat).SetString(string(backend.Trim(b)))
+	if !ok {
+		return backend.Equal(a, b)
+	}
+
+	delta, _ := new(big.Rat).Sub(na, nb).Float64()
+	return math.Abs(delta) <= d.tolerance, nil
+}
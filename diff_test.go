@@ -0,0 +1,94 @@
+package gojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func changes(t *testing.T, a, b string, opts ...DiffOption) []Change {
+	result, err := Diff([]byte(a), []byte(b), opts...)
+	assert.Nil(t, err, "Err must be nil")
+
+	return result
+}
+
+func TestDiff(t *testing.T) {
+	result := changes(t, `{"a": 1, "b": {"c": "x", "d": [1, 2, 3]}, "e": true}`, `{"a": 2, "b": {"c": "x", "d": [1, 4]}, "f": null}`)
+	assert.Equal(t, []Change{
+		{Changed, "/a", []byte(`1`), []byte(`2`)},
+		{Changed, "/b/d/1", []byte(`2`), []byte(`4`)},
+		{Removed, "/b/d/2", []byte(`3`), nil},
+		{Removed, "/e", []byte(`true`), nil},
+		{Added, "/f", nil, []byte(`null`)},
+	}, result, "changes must be equal to the value expected")
+
+	result = changes(t, `{"a": [1]}`, `{"a": {"0": 1}}`)
+	assert.Equal(t, []Change{{Changed, "/a", []byte(`[1]`), []byte(`{"0": 1}`)}}, result, "changes must be equal to the value expected")
+
+	result = changes(t, `1`, `"1"`)
+	assert.Equal(t, []Change{{Changed, "", []byte(`1`), []byte(`"1"`)}}, result, "changes must be equal to the value expected")
+
+	_, err := Diff([]byte(`{"a":`), []byte(`{}`))
+	assert.NotNil(t, err, "Err must not be nil for invalid documents")
+}
+
+func TestDiffEqual(t *testing.T) {
+	tests := [][2]string{
+		{`{"a": 1, "b": [true, null]}`, "{\n\t\"b\" : [ true , null ],\n\t\"a\" : 1\n}"},
+		{`{"a": "é\/"}`, `{"a": "é/"}`},
+		{`[1.0, 100]`, `[1, 1e2]`},
+		{`{"a": 1, "a": 2}`, `{"a": 2}`},
+		{`{"a": 2}`, `{"a": 1, "a": 2}`},
+	}
+
+	for _, test := range tests {
+		assert.Empty(t, changes(t, test[0], test[1]), "%s and %s must have no changes", test[0], test[1])
+	}
+
+	result := changes(t, `{"a": 1, "a": 2}`, `{"a": 1}`)
+	assert.Equal(t, []Change{{Changed, "/a", []byte(`2`), []byte(`1`)}}, result, "the last value of duplicate keys must be compared")
+
+	result = changes(t, `{}`, `{"a": 1, "a": 2}`)
+	assert.Equal(t, []Change{{Added, "/a", nil, []byte(`2`)}}, result, "duplicate keys must be added once")
+}
+
+func TestDiffTolerance(t *testing.T) {
+	a, b := `{"x": 1.0, "y": [2.5]}`, `{"x": 1.05, "y": [2.4]}`
+
+	assert.Equal(t, 2, len(changes(t, a, b)), "changes must be reported without tolerance")
+	assert.Empty(t, changes(t, a, b, WithTolerance(0.1)), "changes within tolerance must be ignored")
+
+	result := changes(t, a, b, WithTolerance(0.07))
+	assert.Equal(t, []Change{{Changed, "/y/0", []byte(`2.5`), []byte(`2.4`)}}, result, "changes must be equal to the value expected")
+
+	result = changes(t, `"1"`, `"1.01"`, WithTolerance(0.1))
+	assert.Equal(t, 1, len(result), "tolerance must not apply to strings")
+}
+
+func TestDiffSets(t *testing.T) {
+	assert.Equal(t, 3, len(changes(t, `[1, 2, 3]`, `[3, 1, 2]`)), "order of elements must matter by default")
+	assert.Empty(t, changes(t, `[1, 2, 3]`, `[3, 1, 2]`, WithArraysAsSets()), "order of elements must be ignored in sets")
+	assert.Empty(t, changes(t, `[{"a": [1, 2]}, 3]`, `[3, {"a": [2, 1]}]`, WithArraysAsSets()), "nested sets must be compared as sets")
+
+	result := changes(t, `[1, 1, 2]`, `[2, 1, 3, 3]`, WithArraysAsSets())
+	assert.Equal(t, []Change{
+		{Removed, "/1", []byte(`1`), nil},
+		{Added, "/2", nil, []byte(`3`)},
+		{Added, "/3", nil, []byte(`3`)},
+	}, result, "duplicates must be matched once")
+
+	result = changes(t, `[1.0, 2]`, `[2.05, 1]`, WithArraysAsSets(), WithTolerance(0.1))
+	assert.Empty(t, result, "tolerance must apply to elements of sets")
+}
+
+func TestDiffPointer(t *testing.T) {
+	result := changes(t, `{"a/b": {"~0": 1}}`, `{"a/b": {"~0": 2}, "": 3}`)
+	assert.Equal(t, []Change{
+		{Changed, "/a~1b/~00", []byte(`1`), []byte(`2`)},
+		{Added, "/", nil, []byte(`3`)},
+	}, result, "paths must be escaped json pointers")
+
+	result = changes(t, `{"a/b~": 1}`, `{}`)
+	assert.Equal(t, "/a~1b~0", result[0].Path, "path must be equal to the value expected")
+}
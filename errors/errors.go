@@ -76,3 +76,86 @@ func NewFloat64OverflowError(index int) error {
 func (n *NumberOverflowError) Error() string {
 	return fmt.Sprintf("invalid json, %s overflow at pos %d", n.typ, n.index)
 }
+
+type EnumError struct {
+	index int
+	typ   string
+	name  string
+}
+
+func NewEnumError(typ, name string, index int) error {
+	return &EnumError{index, typ, name}
+}
+
+func (e *EnumError) Error() string {
+	return fmt.Sprintf("invalid json, unknown %s name %q at pos %d", e.typ, e.name, e.index)
+}
+
+type EnumValueError struct {
+	typ   string
+	value int64
+}
+
+func NewEnumValueError(typ string, value int64) error {
+	return &EnumValueError{typ, value}
+}
+
+func (e *EnumValueError) Error() string {
+	return fmt.Sprintf("unknown %s value %d", e.typ, e.value)
+}
+
+// LimitExceededError is returned when the input exceeds a limit of the decoder, eg: depth, bytes.
+type LimitExceededError struct {
+	Limit string
+	Max   int
+	index int
+}
+
+func NewLimitExceededError(limit string, max, index int) error {
+	return &LimitExceededError{limit, max, index}
+}
+
+func (l *LimitExceededError) Error() string {
+	return fmt.Sprintf("invalid json, %s exceeds the limit %d at pos %d", l.Limit, l.Max, l.index)
+}
+
+type DuplicateKeyError struct {
+	index int
+	key   string
+}
+
+func NewDuplicateKeyError(key string, index int) error {
+	return &DuplicateKeyError{index, key}
+}
+
+func (d *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("invalid json, duplicate key %q at pos %d", d.key, d.index)
+}
+
+// KeyPathNotFoundError is returned when no value is found at a path of the document.
+type KeyPathNotFoundError struct {
+	Path []string
+}
+
+func NewKeyPathNotFoundError(path []string) error {
+	return &KeyPathNotFoundError{path}
+}
+
+func (k *KeyPathNotFoundError) Error() string {
+	return fmt.Sprintf("key path %q not found", k.Path)
+}
+
+// TypeMismatchError is returned when the value at a path is not of the type expected, eg: a string for GetInt64.
+type TypeMismatchError struct {
+	Expected string
+	Actual   string
+	index    int
+}
+
+func NewTypeMismatchError(expected, actual string, index int) error {
+	return &TypeMismatchError{expected, actual, index}
+}
+
+func (t *TypeMismatchError) Error() string {
+	return fmt.Sprintf("invalid json, expected %s value but found %s at pos %d", t.Expected, t.Actual, t.index)
+}
@@ -0,0 +1,75 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/go-fish/gojson/option"
+)
+
+// Check generates the code in memory and compares it with the output file,
+// an error with a summary of the difference is returned if the output file is missing or stale.
+func Check(opt *option.Option) error {
+	out, err := Build(opt)
+	if err != nil {
+		return err
+	}
+
+	old, err := ioutil.ReadFile(opt.Output)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("%s is missing, run gojson to generate it", opt.Output)
+	} else if err != nil {
+		return err
+	}
+
+	if bytes.Equal(old, out) {
+		return nil
+	}
+
+	return fmt.Errorf("%s is stale, run gojson to regenerate it\n%s", opt.Output, diffSummary(old, out))
+}
+
+// diffSummary describes the changed block between the common head and tail lines of old and new.
+func diffSummary(old, new []byte) string {
+	a := strings.Split(string(old), "\n")
+	b := strings.Split(string(new), "\n")
+
+	head := 0
+	for head < len(a) && head < len(b) && a[head] == b[head] {
+		head++
+	}
+
+	tail := 0
+	for tail < len(a)-head && tail < len(b)-head && a[len(a)-1-tail] == b[len(b)-1-tail] {
+		tail++
+	}
+
+	removed := a[head : len(a)-tail]
+	added := b[head : len(b)-tail]
+
+	w := bytes.NewBuffer(make([]byte, 0, 256))
+	fmt.Fprintf(w, "@@ line %d: %d lines removed, %d lines added @@\n", head+1, len(removed), len(added))
+
+	for i, line := range removed {
+		if i == 5 {
+			fmt.Fprintf(w, "- ...\n")
+			break
+		}
+
+		fmt.Fprintf(w, "- %s\n", line)
+	}
+
+	for i, line := range added {
+		if i == 5 {
+			fmt.Fprintf(w, "+ ...\n")
+			break
+		}
+
+		fmt.Fprintf(w, "+ %s\n", line)
+	}
+
+	return w.String()
+}
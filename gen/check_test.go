@@ -0,0 +1,15 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffSummary(t *testing.T) {
+	old := []byte("package a\n\nfunc A() {}\nfunc B() {}\n")
+	new := []byte("package a\n\nfunc A() {}\nfunc C() {}\nfunc D() {}\n")
+
+	summary := diffSummary(old, new)
+	assert.Equal(t, "@@ line 4: 1 lines removed, 2 lines added @@\n- func B() {}\n+ func C() {}\n+ func D() {}\n", summary, "summary must be equal to the value expected")
+}
@@ -5,7 +5,6 @@ import (
 	"go/types"
 
 	"github.com/go-fish/gojson/option"
-	"github.com/go-fish/gojson/util"
 )
 
 func (b *Builder) gArrayEncode(fn string, obj *types.Array, opt *option.Option) {
@@ -13,7 +12,7 @@ func (b *Builder) gArrayEncode(fn string, obj *types.Array, opt *option.Option)
 	b.line("enc.WriteNull()")
 	b.line("} else {")
 
-	value := util.GenerateID("value")
+	value := b.ids.GenerateID("value")
 
 	b.line("enc.WriteByte('[')")
 	b.line("for _, %s := range %s {", value, fn)
@@ -21,7 +20,11 @@ func (b *Builder) gArrayEncode(fn string, obj *types.Array, opt *option.Option)
 
 	switch x := obj.Elem().Underlying().(type) {
 	case *types.Struct:
-		b.gStructEncode(value, new(FieldTag), x, opt)
+		if b.isDelegate(obj.Elem(), true, opt) {
+			b.gDelegateEncode(value)
+		} else {
+			b.gStructEncode(value, new(FieldTag), x, opt)
+		}
 
 	case *types.Map:
 		b.gMapEncode(value, x, opt)
@@ -30,17 +33,32 @@ func (b *Builder) gArrayEncode(fn string, obj *types.Array, opt *option.Option)
 		b.gArrayEncode(value, x, opt)
 
 	case *types.Slice:
-		b.gSliceEncode(value, x, opt)
+		if b.isRawMessage(obj.Elem()) {
+			b.line("if err := enc.EncodeRaw(%s); err != nil {", value)
+			b.line("return err")
+			b.line("}")
+		} else {
+			b.gSliceEncode(value, x, opt)
+		}
 
 	case *types.Pointer:
 		b.gPointerEncode(value, new(FieldTag), x, opt)
 
 	case *types.Interface:
-		b.line("if err := enc.EncodeValue(%s); err != nil {", value)
-		b.line("return nil, err")
+		if b.isTypeParam(obj.Elem()) {
+			b.line("if err := enc.EncodeAny(&%s); err != nil {", value)
+		} else {
+			b.line("if err := enc.EncodeValue(%s); err != nil {", value)
+		}
+		b.line("return err")
 		b.line("}")
 
 	case *types.Basic:
+		if e := b.enum(obj.Elem(), opt); e != nil {
+			b.gEnumEncode(value, e)
+			break
+		}
+
 		alias := value
 		if _, ok := obj.Elem().(*types.Named); ok {
 			alias = fmt.Sprintf("%s(%s)", x.Name(), value)
@@ -48,7 +66,13 @@ func (b *Builder) gArrayEncode(fn string, obj *types.Array, opt *option.Option)
 
 		switch x.Kind() {
 		case types.String:
-			b.line("enc.EncodeString(%s)", alias)
+			if b.isNumber(obj.Elem()) {
+				b.line("if err := enc.EncodeNumber(string(%s)); err != nil {", value)
+				b.line("return err")
+				b.line("}")
+			} else {
+				b.line("enc.EncodeString(%s)", alias)
+			}
 
 		case types.Int:
 			b.line("enc.EncodeInt(%s)", alias)
@@ -92,7 +116,7 @@ func (b *Builder) gArrayEncode(fn string, obj *types.Array, opt *option.Option)
 
 	default:
 		b.line("if err := enc.EncodeValue(%s); err != nil {", value)
-		b.line("return nil, err")
+		b.line("return err")
 		b.line("}")
 	}
 
@@ -104,29 +128,33 @@ func (b *Builder) gArrayEncode(fn string, obj *types.Array, opt *option.Option)
 
 func (b *Builder) gArrayDecode(fn string, obj *types.Array, opt *option.Option) {
 	b.line("if dec.IsNull() {")
-	b.line("%s = nil", fn)
+	b.line("%s = %s{}", fn, b.typeString(obj, opt))
 	b.line("} else if !dec.IsArrayOpen() {")
 	b.line("return errors.NewParseError(dec.Char(), dec.Cursor())")
 	b.line("} else {")
 
 	// empty array
 	b.line("if dec.IsArrayClose() {")
-	b.line("%s = nil", fn)
+	b.line("%s = %s{}", fn, b.typeString(obj, opt))
 	b.line("} else {")
 
-	index := util.GenerateID("index")
-	array := util.GenerateID("array")
+	index := b.ids.GenerateID("index")
+	array := b.ids.GenerateID("array")
 	b.line("%s := 1", array)
 	b.line("%s := 0", index)
 	b.line("for %s > 0 {", array)
 	b.line("if %s < %d {", index, obj.Len())
 
-	value := util.GenerateID("value")
+	value := b.ids.GenerateID("value")
 
 	switch x := obj.Elem().Underlying().(type) {
 	case *types.Struct:
 		b.line("var %s %s", value, b.typeString(obj.Elem(), opt))
-		b.gStructDecode(value, new(FieldTag), x, opt)
+		if b.isDelegate(obj.Elem(), false, opt) {
+			b.gDelegateDecode(value)
+		} else {
+			b.gStructDecode(value, new(FieldTag), x, opt)
+		}
 		b.line("%s[%s] = %s", fn, index, value)
 
 	case *types.Map:
@@ -138,8 +166,17 @@ func (b *Builder) gArrayDecode(fn string, obj *types.Array, opt *option.Option)
 		b.line("%s[%s] = %s", fn, index, value)
 
 	case *types.Slice:
-		b.gSliceDecode(value, x, opt)
-		b.line("%s[%s] = %s", fn, index, value)
+		if b.isRawMessage(obj.Elem()) {
+			b.line("%s, err := dec.ReadValue()", value)
+			b.line("if err != nil {")
+			b.line("return err")
+			b.line("}")
+			b.line("")
+			b.line("%s[%s] = %s(%s)", fn, index, b.typeString(obj.Elem(), opt), value)
+		} else {
+			b.gSliceDecode(value, x, opt)
+			b.line("%s[%s] = %s", fn, index, value)
+		}
 
 	case *types.Pointer:
 		b.line("var %s %s", value, b.typeString(x, opt))
@@ -149,22 +186,36 @@ func (b *Builder) gArrayDecode(fn string, obj *types.Array, opt *option.Option)
 		b.line("}")
 
 	case *types.Interface:
-		b.line("%s, err := dec.DecodeValue()", value)
-		b.line("if err != nil {")
-		b.line("return err")
-		b.line("}")
-		b.line("")
+		if b.isTypeParam(obj.Elem()) {
+			b.gGenericDecode(value, obj.Elem(), opt)
+		} else {
+			b.line("%s, err := dec.DecodeValue()", value)
+			b.line("if err != nil {")
+			b.line("return err")
+			b.line("}")
+			b.line("")
+		}
 		b.line("%s[%s] = %s", fn, index, value)
 
 	case *types.Basic:
+		if e := b.enum(obj.Elem(), opt); e != nil {
+			b.gEnumDecode(value, e)
+			b.line("%s[%s] = %s", fn, index, value)
+			break
+		}
+
 		elem := value
 		if typ := b.typeString(obj.Elem(), opt); typ != x.Name() {
-			elem = fmt.Sprintf("%s(%s)", elem, value)
+			elem = fmt.Sprintf("%s(%s)", typ, value)
 		}
 
 		switch x.Kind() {
 		case types.String:
-			b.line("%s, err := dec.DecodeString()", value)
+			if b.isNumber(obj.Elem()) {
+				b.line("%s, err := dec.DecodeNumber()", value)
+			} else {
+				b.line("%s, err := dec.DecodeString()", value)
+			}
 
 		case types.Int:
 			b.line("%s, err := dec.DecodeInt()", value)
@@ -213,7 +264,7 @@ func (b *Builder) gArrayDecode(fn string, obj *types.Array, opt *option.Option)
 		b.line("%s[%s] = %s", fn, index, elem)
 
 	default:
-		value := util.GenerateID("value")
+		value := b.ids.GenerateID("value")
 		b.line("%s, err := dec.DecodeValue()", value)
 		b.line("if err != nil {")
 		b.line("return err")
@@ -222,6 +273,7 @@ func (b *Builder) gArrayDecode(fn string, obj *types.Array, opt *option.Option)
 		b.line("%s[%s] = %s", fn, index, value)
 	}
 
+	b.line("%s++", index)
 	b.line("} else {")
 	b.line("return fmt.Errorf(\"index out of range at pos %%d\", dec.Cursor())")
 	b.line("}")
@@ -239,12 +291,13 @@ func (b *Builder) gSliceEncode(fn string, obj *types.Slice, opt *option.Option)
 	b.line("} else {")
 
 	// hack []byte
-	if basic, ok := obj.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte {
+	if basic, ok := obj.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte && b.enum(obj.Elem(), opt) == nil {
 		b.line("enc.EncodeBytes(%s)", fn)
+		b.line("}")
 		return
 	}
 
-	value := util.GenerateID("value")
+	value := b.ids.GenerateID("value")
 
 	b.line("enc.WriteByte('[')")
 	b.line("for _, %s := range %s {", value, fn)
@@ -252,7 +305,11 @@ func (b *Builder) gSliceEncode(fn string, obj *types.Slice, opt *option.Option)
 
 	switch x := obj.Elem().Underlying().(type) {
 	case *types.Struct:
-		b.gStructEncode(value, new(FieldTag), x, opt)
+		if b.isDelegate(obj.Elem(), true, opt) {
+			b.gDelegateEncode(value)
+		} else {
+			b.gStructEncode(value, new(FieldTag), x, opt)
+		}
 
 	case *types.Map:
 		b.gMapEncode(value, x, opt)
@@ -261,17 +318,36 @@ func (b *Builder) gSliceEncode(fn string, obj *types.Slice, opt *option.Option)
 		b.gArrayEncode(value, x, opt)
 
 	case *types.Slice:
-		b.gSliceEncode(value, x, opt)
+		if b.isRawMessage(obj.Elem()) {
+			b.line("if err := enc.EncodeRaw(%s); err != nil {", value)
+			b.line("return err")
+			b.line("}")
+		} else {
+			b.gSliceEncode(value, x, opt)
+		}
 
 	case *types.Pointer:
 		b.gPointerEncode(value, new(FieldTag), x, opt)
 
 	case *types.Interface:
-		b.line("if err := enc.EncodeValue(%s); err != nil {", value)
-		b.line("return nil, err")
-		b.line("}")
+		if u := b.union(obj.Elem(), opt); u != nil {
+			b.gUnionEncode(value, u, opt)
+		} else if b.isTypeParam(obj.Elem()) {
+			b.line("if err := enc.EncodeAny(&%s); err != nil {", value)
+			b.line("return err")
+			b.line("}")
+		} else {
+			b.line("if err := enc.EncodeValue(%s); err != nil {", value)
+			b.line("return err")
+			b.line("}")
+		}
 
 	case *types.Basic:
+		if e := b.enum(obj.Elem(), opt); e != nil {
+			b.gEnumEncode(value, e)
+			break
+		}
+
 		alias := value
 		if _, ok := obj.Elem().(*types.Named); ok {
 			alias = fmt.Sprintf("%s(%s)", x.Name(), value)
@@ -279,7 +355,13 @@ func (b *Builder) gSliceEncode(fn string, obj *types.Slice, opt *option.Option)
 
 		switch x.Kind() {
 		case types.String:
-			b.line("enc.EncodeString(%s)", alias)
+			if b.isNumber(obj.Elem()) {
+				b.line("if err := enc.EncodeNumber(string(%s)); err != nil {", value)
+				b.line("return err")
+				b.line("}")
+			} else {
+				b.line("enc.EncodeString(%s)", alias)
+			}
 
 		case types.Int:
 			b.line("enc.EncodeInt(%s)", alias)
@@ -323,7 +405,7 @@ func (b *Builder) gSliceEncode(fn string, obj *types.Slice, opt *option.Option)
 
 	default:
 		b.line("if err := enc.EncodeValue(%s); err != nil {", value)
-		b.line("return nil, err")
+		b.line("return err")
 		b.line("}")
 	}
 
@@ -336,17 +418,18 @@ func (b *Builder) gSliceEncode(fn string, obj *types.Slice, opt *option.Option)
 func (b *Builder) gSliceDecode(fn string, x types.Type, opt *option.Option) {
 	if obj, _ := x.Underlying().(*types.Slice); obj != nil {
 		// hack []byte
-		if basic, ok := obj.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte {
+		if basic, ok := obj.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte && b.enum(obj.Elem(), opt) == nil {
 			b.line("if dec.IsNull() {")
 			b.line("%s = nil", fn)
 			b.line(" } else {")
-			value := util.GenerateID("value")
+			value := b.ids.GenerateID("value")
 			b.line("%s, err := dec.DecodeBytes()", value)
 			b.line("if err != nil {")
 			b.line("return err")
 			b.line("}")
 			b.line("")
 			b.line("%s = %s", fn, value)
+			b.line("}")
 			return
 		}
 
@@ -376,15 +459,19 @@ func (b *Builder) gSliceDecode(fn string, x types.Type, opt *option.Option) {
 		b.line("}")
 		b.line("")
 
-		array := util.GenerateID("array")
+		array := b.ids.GenerateID("array")
 		b.line("for %s := 1; %s > 0; {", array, array)
 
-		value := util.GenerateID("value")
+		value := b.ids.GenerateID("value")
 
 		switch x := obj.Elem().Underlying().(type) {
 		case *types.Struct:
 			b.line("var %s %s", value, b.typeString(obj.Elem(), opt))
-			b.gStructDecode(value, new(FieldTag), x, opt)
+			if b.isDelegate(obj.Elem(), false, opt) {
+				b.gDelegateDecode(value)
+			} else {
+				b.gStructDecode(value, new(FieldTag), x, opt)
+			}
 			b.line("%s = append(%s, %s)", fn, fn, value)
 
 		case *types.Map:
@@ -396,8 +483,17 @@ func (b *Builder) gSliceDecode(fn string, x types.Type, opt *option.Option) {
 			b.line("%s = append(%s, %s)", fn, fn, value)
 
 		case *types.Slice:
-			b.gSliceDecode(value, x, opt)
-			b.line("%s = append(%s, %s)", fn, fn, value)
+			if b.isRawMessage(obj.Elem()) {
+				b.line("%s, err := dec.ReadValue()", value)
+				b.line("if err != nil {")
+				b.line("return err")
+				b.line("}")
+				b.line("")
+				b.line("%s = append(%s, %s(%s))", fn, fn, b.typeString(obj.Elem(), opt), value)
+			} else {
+				b.gSliceDecode(value, x, opt)
+				b.line("%s = append(%s, %s)", fn, fn, value)
+			}
 
 		case *types.Pointer:
 			b.line("var %s %s", value, b.typeString(x, opt))
@@ -407,22 +503,38 @@ func (b *Builder) gSliceDecode(fn string, x types.Type, opt *option.Option) {
 			b.line("}")
 
 		case *types.Interface:
-			b.line("%s, err := dec.DecodeValue()", value)
-			b.line("if err != nil {")
-			b.line("return err")
-			b.line("}")
-			b.line("")
+			if u := b.union(obj.Elem(), opt); u != nil {
+				b.gUnionDecode(value, u, opt)
+			} else if b.isTypeParam(obj.Elem()) {
+				b.gGenericDecode(value, obj.Elem(), opt)
+			} else {
+				b.line("%s, err := dec.DecodeValue()", value)
+				b.line("if err != nil {")
+				b.line("return err")
+				b.line("}")
+				b.line("")
+			}
 			b.line("%s = append(%s, %s)", fn, fn, value)
 
 		case *types.Basic:
+			if e := b.enum(obj.Elem(), opt); e != nil {
+				b.gEnumDecode(value, e)
+				b.line("%s = append(%s, %s)", fn, fn, value)
+				break
+			}
+
 			elem := value
 			if typ := b.typeString(obj.Elem(), opt); typ != x.Name() {
-				elem = fmt.Sprintf("%s(%s)", elem, value)
+				elem = fmt.Sprintf("%s(%s)", typ, value)
 			}
 
 			switch x.Kind() {
 			case types.String:
-				b.line("%s, err := dec.DecodeString()", value)
+				if b.isNumber(obj.Elem()) {
+					b.line("%s, err := dec.DecodeNumber()", value)
+				} else {
+					b.line("%s, err := dec.DecodeString()", value)
+				}
 
 			case types.Int:
 				b.line("%s, err := dec.DecodeInt()", value)
@@ -471,7 +583,7 @@ func (b *Builder) gSliceDecode(fn string, x types.Type, opt *option.Option) {
 			b.line("%s = append(%s, %s)", fn, fn, elem)
 
 		default:
-			value := util.GenerateID("value")
+			value := b.ids.GenerateID("value")
 			b.line("%s, err := dec.DecodeValue()", value)
 			b.line("if err != nil {")
 			b.line("return err")
@@ -480,6 +592,10 @@ func (b *Builder) gSliceDecode(fn string, x types.Type, opt *option.Option) {
 			b.line("%s = append(%s, %s)", fn, fn, value)
 		}
 
+		b.line("if err := dec.CheckElements(len(%s)); err != nil {", fn)
+		b.line("return err")
+		b.line("}")
+		b.line("")
 		b.line("if dec.IsArrayClose() {")
 		b.line("%s--", array)
 		b.line("}")
@@ -0,0 +1,82 @@
+package gen
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/go-fish/gojson/option"
+)
+
+// hasGoJSON reports whether the named struct typ has the methods generated by gojson, either generated
+// in the same run, which is decided by the option of its package, or already existing in its package.
+func (b *Builder) hasGoJSON(typ types.Type, encode bool, opt *option.Option) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return false
+	}
+
+	obj := named.Origin().Obj()
+	if o, ok := opt.Packages[obj.Pkg().Path()]; ok {
+		if o.Pkg.Scope().Lookup(obj.Name()) == nil {
+			return false
+		}
+
+		if encode {
+			return o.TypeMode(obj.Name()).IsEncode()
+		}
+
+		return o.TypeMode(obj.Name()).IsDecode()
+	}
+
+	method := "DecodeFrom"
+	if encode {
+		method = "EncodeTo"
+	}
+
+	fn, _, _ := types.LookupFieldOrMethod(types.NewPointer(typ), false, obj.Pkg(), method)
+	_, ok = fn.(*types.Func)
+	return ok
+}
+
+// isDelegate reports whether values of typ call the generated methods of typ instead of being inlined,
+// which are values of recursive types, of types in other packages and of all types if not inline.
+// recursive types without the methods are reported by the error of builder.
+func (b *Builder) isDelegate(typ types.Type, encode bool, opt *option.Option) bool {
+	if b.isRecursive(typ) {
+		if b.err == nil && !b.hasGoJSON(typ, encode, opt) {
+			mode := "decode"
+			if encode {
+				mode = "encode"
+			}
+
+			b.err = fmt.Errorf("Recursive type %s must be generated with %s too", typ, mode)
+		}
+
+		return true
+	}
+
+	if !b.hasGoJSON(typ, encode, opt) {
+		return false
+	}
+
+	named := types.Unalias(typ).(*types.Named)
+	return !opt.IsLocal(named.Obj().Pkg()) || !opt.Inline
+}
+
+// gDelegateEncode encodes fn, which must be addressable, into the encoder shared with the method of fn.
+func (b *Builder) gDelegateEncode(fn string) {
+	b.line("if err := %s.EncodeTo(enc); err != nil {", fn)
+	b.line("return err")
+	b.line("}")
+}
+
+// gDelegateDecode decodes the next value into fn, which must be addressable, with the decoder shared with the method of fn.
+func (b *Builder) gDelegateDecode(fn string) {
+	b.line("if err := %s.DecodeFrom(dec); err != nil {", fn)
+	b.line("return err")
+	b.line("}")
+}
@@ -0,0 +1,17 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson/option"
+)
+
+func TestGenerateNested(t *testing.T) {
+	testPackages(t, []string{"nested", "nested/inner"}, nil)
+}
+
+func TestGenerateNestedNoInline(t *testing.T) {
+	testPackages(t, []string{"nested", "nested/inner"}, func(opt *option.Option) {
+		opt.Inline = false
+	})
+}
@@ -0,0 +1,65 @@
+package gen
+
+import "go/types"
+
+// seenSet is the bitmask of the keys decoded in an object, used to detect duplicate keys.
+type seenSet struct {
+	name string
+	bits int
+}
+
+// countKeys returns the number of keys of obj, including the keys of inline structs.
+func (b *Builder) countKeys(obj *types.Struct) int {
+	n := 0
+
+	for i := 0; i < obj.NumFields(); i++ {
+		tag := b.parseFieldTag(obj.Tag(i), obj.Field(i))
+		if tag.ignore {
+			continue
+		}
+
+		typ := obj.Field(i).Type()
+		if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+
+		if x, ok := typ.Underlying().(*types.Struct); ok && tag.inline {
+			n += b.countKeys(x)
+		} else {
+			n++
+		}
+	}
+
+	return n
+}
+
+// pushSeen declares the bitmask for the keys of obj, it must be paired with popSeen after the loop of keys.
+func (b *Builder) pushSeen(obj *types.Struct) {
+	s := &seenSet{name: b.ids.GenerateID("seen")}
+	b.line("var %s [%d]uint64", s.name, b.countKeys(obj)/64+1)
+	b.seens = append(b.seens, s)
+}
+
+func (b *Builder) popSeen() {
+	if s := b.seens[len(b.seens)-1]; s.bits == 0 {
+		b.line("_ = %s", s.name)
+	}
+
+	b.seens = b.seens[:len(b.seens)-1]
+}
+
+// gCase starts the case of key in the switch of keys, the value of a duplicate key is skipped
+// or rejected by the decoder according to its policy.
+func (b *Builder) gCase(key string) {
+	b.line("case %q:", key)
+
+	s := b.seens[len(b.seens)-1]
+	b.line("if dup, err := dec.Seen(&%s[%d], %d, %q); err != nil {", s.name, s.bits/64, s.bits%64, key)
+	b.line("return err")
+	b.line("} else if dup {")
+	b.line("break")
+	b.line("}")
+	b.line("")
+
+	s.bits++
+}
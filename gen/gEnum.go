@@ -0,0 +1,203 @@
+package gen
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/go-fish/gojson/option"
+)
+
+// Enum is a named integer type encoded as the names of its constants. The names
+// are the results of the String method, or the constant names when the type
+// has a //gojson:enum directive and no String method, eg:
+//
+//	//gojson:enum trimprefix=Status
+//	type Status int
+type Enum struct {
+	name   string
+	typ    types.Type
+	consts []string
+	names  []string
+}
+
+func (b *Builder) parseEnums(opt *option.Option) error {
+	b.enums = make(map[string]*Enum)
+
+	for _, name := range opt.Pkg.Scope().Names() {
+		obj, ok := opt.Pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok || obj.IsAlias() {
+			continue
+		}
+
+		basic, ok := obj.Type().Underlying().(*types.Basic)
+		if !ok || basic.Info()&types.IsInteger == 0 {
+			continue
+		}
+
+		args, directive := opt.Directive(name, "enum")
+		stringer := b.isStringer(obj.Type(), opt)
+		if !directive && !(opt.Enum && stringer) {
+			continue
+		}
+
+		var prefix string
+		for _, arg := range args {
+			kv := strings.SplitN(arg, "=", 2)
+			if len(kv) != 2 || kv[0] != "trimprefix" {
+				return fmt.Errorf("Invalid enum %s, bad argument %s", name, arg)
+			}
+
+			prefix = kv[1]
+		}
+
+		e := &Enum{name: name, typ: obj.Type()}
+		values := make(map[string]bool)
+		names := make(map[string]bool)
+
+		for _, c := range opt.Pkg.Scope().Names() {
+			v, ok := opt.Pkg.Scope().Lookup(c).(*types.Const)
+			if !ok || !types.Identical(v.Type(), obj.Type()) || values[v.Val().ExactString()] {
+				continue
+			}
+
+			values[v.Val().ExactString()] = true
+			e.consts = append(e.consts, c)
+
+			if stringer {
+				e.names = append(e.names, fmt.Sprintf("%s.String()", c))
+				continue
+			}
+
+			n := strings.TrimPrefix(c, prefix)
+			if names[n] {
+				return fmt.Errorf("Invalid enum %s, duplicate name %s", name, n)
+			}
+
+			names[n] = true
+			e.names = append(e.names, strconv.Quote(n))
+		}
+
+		if len(e.consts) == 0 {
+			if directive {
+				return fmt.Errorf("Invalid enum %s, no constants declared", name)
+			}
+
+			continue
+		}
+
+		b.enums[name] = e
+	}
+
+	return nil
+}
+
+func (b *Builder) isStringer(typ types.Type, opt *option.Option) bool {
+	obj, _, _ := types.LookupFieldOrMethod(typ, false, opt.Pkg, "String")
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != 0 || sig.Results().Len() != 1 {
+		return false
+	}
+
+	basic, ok := sig.Results().At(0).Type().(*types.Basic)
+	return ok && basic.Kind() == types.String
+}
+
+func (b *Builder) enum(typ types.Type, opt *option.Option) *Enum {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || !opt.IsLocal(named.Obj().Pkg()) {
+		return nil
+	}
+
+	return b.enums[named.Obj().Name()]
+}
+
+func (b *Builder) enumNames(e *Enum) string {
+	return fmt.Sprintf("gojson%sNames", e.name)
+}
+
+func (b *Builder) enumValues(e *Enum) string {
+	return fmt.Sprintf("gojson%sValues", e.name)
+}
+
+// gEnumTables generates the lookup tables between values and names of all enums.
+func (b *Builder) gEnumTables(opt *option.Option) {
+	for _, name := range opt.Pkg.Scope().Names() {
+		e, ok := b.enums[name]
+		if !ok {
+			continue
+		}
+
+		b.line("var %s = map[%s]string{", b.enumNames(e), e.name)
+		for i, c := range e.consts {
+			b.line("%s: %s,", c, e.names[i])
+		}
+		b.line("}")
+		b.line("")
+
+		b.line("var %s = map[string]%s{", b.enumValues(e), e.name)
+		for i, c := range e.consts {
+			b.line("%s: %s,", e.names[i], c)
+		}
+		b.line("}")
+		b.line("")
+	}
+}
+
+func (b *Builder) gEnumEncode(fn string, e *Enum) {
+	name := b.ids.GenerateID("name")
+	b.line("if %s, ok := %s[%s]; ok {", name, b.enumNames(e), fn)
+	b.line("enc.EncodeString(%s)", name)
+	b.line("} else {")
+	b.line("return errors.NewEnumValueError(%q, int64(%s))", e.name, fn)
+	b.line("}")
+}
+
+// gEnumDecode declares value with the enum type and decodes into it.
+func (b *Builder) gEnumDecode(value string, e *Enum) {
+	name := b.ids.GenerateID("name")
+	elem := b.ids.GenerateID("elem")
+
+	b.line("var %s %s", value, e.name)
+	b.line("if !dec.IsNull() {")
+	b.line("%s, err := dec.DecodeString()", name)
+	b.line("if err != nil {")
+	b.line("return err")
+	b.line("}")
+	b.line("")
+	b.line("%s, ok := %s[%s]", elem, b.enumValues(e), name)
+	b.line("if !ok {")
+	b.line("return errors.NewEnumError(%q, %s, dec.Cursor())", e.name, name)
+	b.line("}")
+	b.line("")
+	b.line("%s = %s", value, elem)
+	b.line("}")
+}
+
+// gEnumKeyEncode looks up the name of the map key fn and returns the variable holding it.
+func (b *Builder) gEnumKeyEncode(fn string, e *Enum) string {
+	name := b.ids.GenerateID("name")
+	b.line("%s, ok := %s[%s]", name, b.enumNames(e), fn)
+	b.line("if !ok {")
+	b.line("return errors.NewEnumValueError(%q, int64(%s))", e.name, fn)
+	b.line("}")
+	b.line("")
+	return name
+}
+
+// gEnumKeyDecode looks up the value of the map key name and returns the variable holding it.
+func (b *Builder) gEnumKeyDecode(name string, e *Enum) string {
+	value := b.ids.GenerateID("value")
+	b.line("%s, ok := %s[%s]", value, b.enumValues(e), name)
+	b.line("if !ok {")
+	b.line("return errors.NewEnumError(%q, %s, dec.Cursor())", e.name, name)
+	b.line("}")
+	b.line("")
+	return value
+}
@@ -0,0 +1,13 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson/option"
+)
+
+func TestGenerateEnum(t *testing.T) {
+	testPackage(t, "enum", func(opt *option.Option) {
+		opt.Enum = true
+	})
+}
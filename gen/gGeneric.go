@@ -0,0 +1,53 @@
+package gen
+
+import (
+	"go/types"
+	"strings"
+
+	"github.com/go-fish/gojson/option"
+)
+
+// isTypeParam reports whether typ is a type parameter of a generic type,
+// values of type parameters are encoded and decoded by the runtime hooks EncodeAny and DecodeAny.
+func (b *Builder) isTypeParam(typ types.Type) bool {
+	_, ok := typ.(*types.TypeParam)
+	return ok
+}
+
+// typeParams returns the type parameters list used in receivers of generic type, eg: [K, V].
+func (b *Builder) typeParams(name string, opt *option.Option) string {
+	named, ok := opt.Pkg.Scope().Lookup(name).Type().(*types.Named)
+	if !ok || named.TypeParams().Len() == 0 {
+		return ""
+	}
+
+	params := make([]string, named.TypeParams().Len())
+	for i := range params {
+		params[i] = named.TypeParams().At(i).Obj().Name()
+	}
+
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+// typeArgs returns the type arguments list of instantiated generic type, eg: [string, int].
+func (b *Builder) typeArgs(list *types.TypeList, opt *option.Option) string {
+	if list.Len() == 0 {
+		return ""
+	}
+
+	args := make([]string, list.Len())
+	for i := range args {
+		args[i] = b.typeString(list.At(i), opt)
+	}
+
+	return "[" + strings.Join(args, ", ") + "]"
+}
+
+// gGenericDecode declares value with the type parameter and decodes into it.
+func (b *Builder) gGenericDecode(value string, typ types.Type, opt *option.Option) {
+	b.line("var %s %s", value, b.typeString(typ, opt))
+	b.line("if err := dec.DecodeAny(&%s); err != nil {", value)
+	b.line("return err")
+	b.line("}")
+	b.line("")
+}
@@ -0,0 +1,7 @@
+package gen
+
+import "testing"
+
+func TestGenerateGeneric(t *testing.T) {
+	testPackage(t, "generic", nil)
+}
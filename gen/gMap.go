@@ -3,10 +3,8 @@ package gen
 import (
 	"fmt"
 	"go/types"
-	"strings"
 
 	"github.com/go-fish/gojson/option"
-	"github.com/go-fish/gojson/util"
 )
 
 func (b *Builder) gMapEncode(fn string, obj *types.Map, opt *option.Option) {
@@ -14,16 +12,24 @@ func (b *Builder) gMapEncode(fn string, obj *types.Map, opt *option.Option) {
 	b.line("enc.WriteNull()")
 	b.line("} else {")
 
-	key := util.GenerateID("key")
-	value := util.GenerateID("value")
+	key := b.ids.GenerateID("key")
+	value := b.ids.GenerateID("value")
 
 	b.line("enc.WriteByte('{')")
 	b.line("for %s, %s := range %s {", key, value, fn)
 
+	if e := b.enum(obj.Key(), opt); e != nil {
+		key = b.gEnumKeyEncode(key, e)
+	}
+
 	switch x := obj.Elem().Underlying().(type) {
 	case *types.Struct:
 		b.line("enc.WriteKey(%s)", key)
-		b.gStructEncode(value, new(FieldTag), x, opt)
+		if b.isDelegate(obj.Elem(), true, opt) {
+			b.gDelegateEncode(value)
+		} else {
+			b.gStructEncode(value, new(FieldTag), x, opt)
+		}
 
 	case *types.Map:
 		b.line("enc.WriteKey(%s)", key)
@@ -34,8 +40,14 @@ func (b *Builder) gMapEncode(fn string, obj *types.Map, opt *option.Option) {
 		b.gArrayEncode(value, x, opt)
 
 	case *types.Slice:
-		b.line("enc.WriteKey(%s)", key)
-		b.gSliceEncode(value, x, opt)
+		if b.isRawMessage(obj.Elem()) {
+			b.line("if err := enc.EncodeKeyRaw(%s, %s); err != nil {", key, value)
+			b.line("return err")
+			b.line("}")
+		} else {
+			b.line("enc.WriteKey(%s)", key)
+			b.gSliceEncode(value, x, opt)
+		}
 
 	case *types.Pointer:
 		b.line("enc.WriteKey(%s)", key)
@@ -44,11 +56,26 @@ func (b *Builder) gMapEncode(fn string, obj *types.Map, opt *option.Option) {
 		b.line("}")
 
 	case *types.Interface:
-		b.line("if err := enc.EncodeKeyValue(%s, %s); err != nil {", key, value)
-		b.line("return nil, err")
-		b.line("}")
+		if u := b.union(obj.Elem(), opt); u != nil {
+			b.line("enc.WriteKey(%s)", key)
+			b.gUnionEncode(value, u, opt)
+		} else if b.isTypeParam(obj.Elem()) {
+			b.line("if err := enc.EncodeKeyAny(%s, &%s); err != nil {", key, value)
+			b.line("return err")
+			b.line("}")
+		} else {
+			b.line("if err := enc.EncodeKeyValue(%s, %s); err != nil {", key, value)
+			b.line("return err")
+			b.line("}")
+		}
 
 	case *types.Basic:
+		if e := b.enum(obj.Elem(), opt); e != nil {
+			b.line("enc.WriteKey(%s)", key)
+			b.gEnumEncode(value, e)
+			break
+		}
+
 		alias := value
 		if typ := b.typeString(obj.Elem(), opt); typ != x.Name() {
 			alias = fmt.Sprintf("%s(%s)", typ, value)
@@ -56,7 +83,13 @@ func (b *Builder) gMapEncode(fn string, obj *types.Map, opt *option.Option) {
 
 		switch x.Kind() {
 		case types.String:
-			b.line("enc.EncodeKeyString(%s, %s)", key, alias)
+			if b.isNumber(obj.Elem()) {
+				b.line("if err := enc.EncodeKeyNumber(%s, string(%s)); err != nil {", key, value)
+				b.line("return err")
+				b.line("}")
+			} else {
+				b.line("enc.EncodeKeyString(%s, %s)", key, alias)
+			}
 
 		case types.Int:
 			b.line("enc.EncodeKeyInt(%s, %s)", key, alias)
@@ -100,7 +133,7 @@ func (b *Builder) gMapEncode(fn string, obj *types.Map, opt *option.Option) {
 
 	default:
 		b.line("if err := enc.EncodeKeyValue(%s, %s); err != nil {", key, value)
-		b.line("return nil, err")
+		b.line("return err")
 		b.line("}")
 	}
 
@@ -128,11 +161,13 @@ func (b *Builder) gMapDecode(fn string, x types.Type, opt *option.Option) {
 		b.line("%s = make(%s)", fn, b.typeString(x, opt))
 		b.line("}")
 
-		object := util.GenerateID("obj")
+		object := b.ids.GenerateID("obj")
+		seen := b.ids.GenerateID("seen")
+		b.line("var %s map[string]struct{}", seen)
 		b.line("for %s := 1; %s > 0; {", object, object)
 
-		key := util.GenerateID("key")
-		value := util.GenerateID("value")
+		key := b.ids.GenerateID("key")
+		value := b.ids.GenerateID("value")
 
 		b.line("%s, err := dec.NextKey()", key)
 		b.line("if err != nil {")
@@ -140,24 +175,45 @@ func (b *Builder) gMapDecode(fn string, x types.Type, opt *option.Option) {
 		b.line("}")
 		b.line("")
 
+		// skip or reject the value of duplicate key
+		b.line("if dup, err := dec.SeenKey(&%s, %s); err != nil {", seen, key)
+		b.line("return err")
+		b.line("} else if !dup {")
+
 		alias := key
-		if typ := b.typeString(obj.Key(), opt); typ != "string" {
+		if e := b.enum(obj.Key(), opt); e != nil {
+			alias = b.gEnumKeyDecode(key, e)
+		} else if typ := b.typeString(obj.Key(), opt); typ != "string" {
 			alias = fmt.Sprintf("%s(%s)", typ, key)
 		}
 
 		switch x := obj.Elem().Underlying().(type) {
 		case *types.Struct:
 			b.line("var %s %s", value, b.typeString(obj.Elem(), opt))
-			b.gStructDecode(value, new(FieldTag), x, opt)
+			if b.isDelegate(obj.Elem(), false, opt) {
+				b.gDelegateDecode(value)
+			} else {
+				b.gStructDecode(value, new(FieldTag), x, opt)
+			}
 			b.line("%s[%s] = %s", fn, alias, value)
 
 		case *types.Array:
+			b.line("var %s %s", value, b.typeString(obj.Elem(), opt))
 			b.gArrayDecode(value, x, opt)
 			b.line("%s[%s] = %s", fn, alias, value)
 
 		case *types.Slice:
-			b.gSliceDecode(value, x, opt)
-			b.line("%s[%s] = %s", fn, alias, value)
+			if b.isRawMessage(obj.Elem()) {
+				b.line("%s, err := dec.ReadValue()", value)
+				b.line("if err != nil {")
+				b.line("return err")
+				b.line("}")
+				b.line("")
+				b.line("%s[%s] = %s(%s)", fn, alias, b.typeString(obj.Elem(), opt), value)
+			} else {
+				b.gSliceDecode(value, x, opt)
+				b.line("%s[%s] = %s", fn, alias, value)
+			}
 
 		case *types.Pointer:
 			b.line("var %s %s", value, b.typeString(x, opt))
@@ -167,14 +223,26 @@ func (b *Builder) gMapDecode(fn string, x types.Type, opt *option.Option) {
 			b.line("}")
 
 		case *types.Interface:
-			b.line("%s, err := dec.DecodeValue()", value)
-			b.line("if err != nil {")
-			b.line("return err")
-			b.line("}")
-			b.line("")
+			if u := b.union(obj.Elem(), opt); u != nil {
+				b.gUnionDecode(value, u, opt)
+			} else if b.isTypeParam(obj.Elem()) {
+				b.gGenericDecode(value, obj.Elem(), opt)
+			} else {
+				b.line("%s, err := dec.DecodeValue()", value)
+				b.line("if err != nil {")
+				b.line("return err")
+				b.line("}")
+				b.line("")
+			}
 			b.line("%s[%s] = %s", fn, alias, value)
 
 		case *types.Basic:
+			if e := b.enum(obj.Elem(), opt); e != nil {
+				b.gEnumDecode(value, e)
+				b.line("%s[%s] = %s", fn, alias, value)
+				break
+			}
+
 			elem := value
 			if typ := b.typeString(obj.Elem(), opt); typ != x.Name() {
 				elem = fmt.Sprintf("%s(%s)", typ, value)
@@ -182,7 +250,11 @@ func (b *Builder) gMapDecode(fn string, x types.Type, opt *option.Option) {
 
 			switch x.Kind() {
 			case types.String:
-				b.line("%s, err := dec.DecodeString()", value)
+				if b.isNumber(obj.Elem()) {
+					b.line("%s, err := dec.DecodeNumber()", value)
+				} else {
+					b.line("%s, err := dec.DecodeString()", value)
+				}
 
 			case types.Int:
 				b.line("%s, err := dec.DecodeInt()", value)
@@ -231,7 +303,7 @@ func (b *Builder) gMapDecode(fn string, x types.Type, opt *option.Option) {
 			b.line("%s[%s] = %s", fn, alias, elem)
 
 		default:
-			value := util.GenerateID("value")
+			value := b.ids.GenerateID("value")
 			b.line("%s, err := dec.DecodeValue()", value)
 			b.line("if err != nil {")
 			b.line("return err")
@@ -239,6 +311,13 @@ func (b *Builder) gMapDecode(fn string, x types.Type, opt *option.Option) {
 			b.line("")
 			b.line("%s[%s] = %s", fn, alias, value)
 		}
+		b.line("}")
+		b.line("")
+
+		b.line("if err := dec.CheckElements(len(%s)); err != nil {", fn)
+		b.line("return err")
+		b.line("}")
+		b.line("")
 
 		b.line("if dec.IsObjectClose() {")
 		b.line("%s--", object)
@@ -255,24 +334,63 @@ func (b *Builder) gFieldEncode(fn string, parent, self *FieldTag, obj *types.Str
 
 		// add import
 		if !opt.IsLocal(field.Pkg()) {
-			pkg := field.Pkg().Path()
-			if index := strings.Index(pkg, "/vendor/"); index > 0 {
-				pkg = pkg[index+8:]
+			b.importName(field.Pkg())
+		}
+
+		if e := b.enum(field.Type(), opt); e != nil {
+			fn = fmt.Sprintf("%s.%s", fn, field.Name())
+			if self.pointer {
+				fn = "*" + fn
 			}
 
-			b.Imports[field.Pkg().Name()] = pkg
+			if self.omitempty {
+				b.line("if %s != 0 {", fn)
+			}
+
+			b.line("enc.WriteKey(%q)", self.name)
+			b.gEnumEncode(fn, e)
+
+			if self.omitempty {
+				b.line("}")
+			}
+
+			return nil
+		}
+
+		if b.isRawMessage(field.Type()) {
+			fn = fmt.Sprintf("%s.%s", fn, field.Name())
+			if self.pointer {
+				fn = "*" + fn
+			}
+
+			if self.omitempty {
+				b.line("if len(%s) > 0 {", fn)
+			}
+
+			b.line("if err := enc.EncodeKeyRaw(%q, %s); err != nil {", self.name, fn)
+			b.line("return err")
+			b.line("}")
+
+			if self.omitempty {
+				b.line("}")
+			}
+
+			return nil
 		}
 
 		switch x := field.Type().Underlying().(type) {
 		case *types.Struct:
 			fn = fmt.Sprintf("%s.%s", fn, field.Name())
 
-			if !self.inline && ((!opt.Inline && opt.IsLocal(field.Pkg())) || opt.IsMarshaler(field.Type())) {
+			if !self.inline && b.isDelegate(field.Type(), true, opt) {
+				b.line("enc.WriteKey(%q)", self.name)
+				b.gDelegateEncode(fn)
+			} else if !self.inline && opt.IsMarshaler(field.Type()) {
 				b.line("enc.WriteKey(%q)", self.name)
-				tmpData := util.GenerateID("data")
+				tmpData := b.ids.GenerateID("data")
 				b.line("%s, err := %s.MarshalJSON()", tmpData, fn)
 				b.line("if err != nil {")
-				b.line("return nil, err")
+				b.line("return err")
 				b.line("}")
 				b.line("")
 				b.line("enc.WriteBytes(%s)", tmpData)
@@ -338,6 +456,8 @@ func (b *Builder) gFieldEncode(fn string, parent, self *FieldTag, obj *types.Str
 				self.keys = b.getKeys(obj)
 			}
 
+			self.pointer = true
+
 			f := types.NewVar(field.Pos(), field.Pkg(), field.Name(), x.Elem())
 			b.line("if %s.%s != nil {", fn, field.Name())
 			b.gFieldEncode(fn, parent, self, obj, f, opt)
@@ -346,27 +466,58 @@ func (b *Builder) gFieldEncode(fn string, parent, self *FieldTag, obj *types.Str
 		case *types.Interface:
 			fn = fmt.Sprintf("%s.%s", fn, field.Name())
 
-			if self.omitempty {
+			if u := b.union(field.Type(), opt); u != nil {
+				if self.omitempty {
+					b.line("if %s != nil {", fn)
+				}
+
+				b.line("enc.WriteKey(%q)", self.name)
+				b.gUnionEncode(fn, u, opt)
+
+				if self.omitempty {
+					b.line("}")
+				}
+			} else if b.isTypeParam(field.Type()) {
+				b.line("if err := enc.EncodeKeyAny(%q, &%s); err != nil {", self.name, fn)
+				b.line("return err")
+				b.line("}")
+			} else if self.omitempty {
 				b.line("if %s != nil {", fn)
 				b.line("if err := enc.EncodeKeyValue(%q, %s); err != nil {", self.name, fn)
-				b.line("return nil, err")
+				b.line("return err")
 				b.line("}")
 				b.line("}")
 			} else {
 				b.line("if err := enc.EncodeKeyValue(%q, %s); err != nil {", self.name, fn)
-				b.line("return nil, err")
+				b.line("return err")
 				b.line("}")
 			}
 
 		case *types.Basic:
 			fn = fmt.Sprintf("%s.%s", fn, field.Name())
+			if self.pointer {
+				fn = "*" + fn
+			}
+
 			if typ := b.typeString(field.Type(), opt); typ != x.Name() {
 				fn = fmt.Sprintf("%s(%s)", x.Name(), fn)
 			}
 
 			switch x.Kind() {
 			case types.String:
-				if self.omitempty {
+				if b.isNumber(field.Type()) {
+					if self.omitempty {
+						b.line("if %s != \"\" {", fn)
+					}
+
+					b.line("if err := enc.EncodeKeyNumber(%q, %s); err != nil {", self.name, fn)
+					b.line("return err")
+					b.line("}")
+
+					if self.omitempty {
+						b.line("}")
+					}
+				} else if self.omitempty {
 					b.line("if %s != \"\" {", fn)
 					b.line("enc.EncodeKeyString(%q, %s)", self.name, fn)
 					b.line("}")
@@ -496,7 +647,7 @@ func (b *Builder) gFieldEncode(fn string, parent, self *FieldTag, obj *types.Str
 
 		default:
 			b.line("if err := enc.EncodeKeyValue(%q, %s); err != nil {", self.name, fn)
-			b.line("return nil, err")
+			b.line("return err")
 			b.line("}")
 		}
 	}
@@ -505,6 +656,9 @@ func (b *Builder) gFieldEncode(fn string, parent, self *FieldTag, obj *types.Str
 }
 
 func (b *Builder) gStructEncode(fn string, parent *FieldTag, obj *types.Struct, opt *option.Option) {
+	b.enterStruct(obj)
+	defer b.leaveStruct()
+
 	if b.isRoot(fn) {
 		b.line("enc.WriteByte('{')")
 	}
@@ -528,24 +682,77 @@ func (b *Builder) gStructEncode(fn string, parent *FieldTag, obj *types.Struct,
 func (b *Builder) gFieldDecode(fn string, parent, self *FieldTag, obj *types.Struct, field *types.Var, opt *option.Option) {
 	if b.needPrint(parent, self) {
 		if !opt.IsLocal(field.Pkg()) {
-			pkg := field.Pkg().Path()
-			if index := strings.Index(pkg, "/vendor/"); index > 0 {
-				pkg = pkg[index+8:]
+			b.importName(field.Pkg())
+		}
+
+		if e := b.enum(field.Type(), opt); e != nil {
+			fn = fmt.Sprintf("%s.%s", fn, field.Name())
+			value := b.ids.GenerateID("value")
+
+			b.gCase(self.name)
+			b.gEnumDecode(value, e)
+
+			if self.pointer {
+				b.line("%s = &%s", fn, value)
+			} else {
+				b.line("%s = %s", fn, value)
+			}
+
+			return
+		}
+
+		if b.isRawMessage(field.Type()) {
+			fn = fmt.Sprintf("%s.%s", fn, field.Name())
+			value := b.ids.GenerateID("value")
+
+			b.gCase(self.name)
+			b.line("%s, err := dec.ReadValue()", value)
+			b.line("if err != nil {")
+			b.line("return err")
+			b.line("}")
+			b.line("")
+
+			if self.pointer {
+				raw := b.ids.GenerateID("raw")
+				b.line("if %s == nil {", value)
+				b.line("%s = nil", fn)
+				b.line("} else {")
+				b.line("%s := %s(%s)", raw, b.typeString(field.Type(), opt), value)
+				b.line("%s = &%s", fn, raw)
+				b.line("}")
+			} else {
+				b.line("%s = %s(%s)", fn, b.typeString(field.Type(), opt), value)
 			}
 
-			b.Imports[field.Pkg().Name()] = pkg
+			return
 		}
 
 		switch x := field.Type().Underlying().(type) {
 		case *types.Struct:
 			fn = fmt.Sprintf("%s.%s", fn, field.Name())
 
-			if !self.inline && ((!opt.Inline && opt.IsLocal(field.Pkg())) || opt.IsUnmarshaler(field.Type())) {
-				b.line("case %q:", self.name)
-				data := util.GenerateID("data")
+			if !self.inline && b.isDelegate(field.Type(), false, opt) {
+				b.gCase(self.name)
+				if self.pointer {
+					b.line("if dec.IsNull() {")
+					b.line("%s = nil", fn)
+					b.line("} else {")
+					b.line("if %s == nil {", fn)
+					b.line("%s = new(%s)", fn, b.typeString(field.Type(), opt))
+					b.line("}")
+					b.line("")
+					b.gDelegateDecode(fn)
+					b.line("}")
+				} else {
+					b.gDelegateDecode(fn)
+				}
+				b.line("")
+			} else if !self.inline && opt.IsUnmarshaler(field.Type()) {
+				b.gCase(self.name)
+				data := b.ids.GenerateID("data")
 				b.line("%s, err := dec.ReadValue()", data)
 				b.line("if err != nil {")
-				b.line("return nil")
+				b.line("return err")
 				b.line("}")
 				b.line("")
 
@@ -564,10 +771,10 @@ func (b *Builder) gFieldDecode(fn string, parent, self *FieldTag, obj *types.Str
 				b.line("}")
 				b.line("")
 			} else {
-				object := util.GenerateID("obj")
+				object := b.ids.GenerateID("obj")
 
 				if !self.inline {
-					b.line("case %q:", self.name)
+					b.gCase(self.name)
 
 					b.line("if dec.IsNull() {")
 					if self.pointer {
@@ -595,10 +802,11 @@ func (b *Builder) gFieldDecode(fn string, parent, self *FieldTag, obj *types.Str
 						b.line("")
 					}
 
+					b.pushSeen(x)
 					b.line("for %s := 1; %s > 0; {", object, object)
 
 					// read key
-					key := util.GenerateID("key")
+					key := b.ids.GenerateID("key")
 					b.line("%s, err := dec.NextKey()", key)
 					b.line("if err != nil {")
 					b.line("return err")
@@ -627,6 +835,7 @@ func (b *Builder) gFieldDecode(fn string, parent, self *FieldTag, obj *types.Str
 					b.line("}")
 
 					b.line("}")
+					b.popSeen()
 					b.line("}")
 					b.line("}")
 				}
@@ -635,17 +844,19 @@ func (b *Builder) gFieldDecode(fn string, parent, self *FieldTag, obj *types.Str
 		case *types.Map:
 			fn = fmt.Sprintf("%s.%s", fn, field.Name())
 
-			b.line("case %q:", self.name)
+			b.gCase(self.name)
 			b.gMapDecode(fn, field.Type(), opt)
 
 		case *types.Array:
 			fn = fmt.Sprintf("%s.%s", fn, field.Name())
+
+			b.gCase(self.name)
 			b.gArrayDecode(fn, x, opt)
 
 		case *types.Slice:
 			fn = fmt.Sprintf("%s.%s", fn, field.Name())
 
-			b.line("case %q:", self.name)
+			b.gCase(self.name)
 			b.gSliceDecode(fn, field.Type(), opt)
 
 		case *types.Pointer:
@@ -663,23 +874,35 @@ func (b *Builder) gFieldDecode(fn string, parent, self *FieldTag, obj *types.Str
 		case *types.Interface:
 			fn = fmt.Sprintf("%s.%s", fn, field.Name())
 
-			value := util.GenerateID("value")
+			value := b.ids.GenerateID("value")
 			alias := value
 			if typ := b.typeString(field.Type(), opt); typ != "interface{}" {
 				alias = fmt.Sprintf("%s(%s)", typ, value)
 			}
 
-			b.line("case %q:", self.name)
-			b.line("%s, err := dec.DecodeValue()", value)
-			b.line("if err != nil {")
-			b.line("return err")
-			b.line("}")
-			b.line("")
-			b.line("%s = %s", fn, alias)
+			b.gCase(self.name)
+			if u := b.union(field.Type(), opt); u != nil {
+				b.gUnionDecode(value, u, opt)
+				b.line("%s = %s", fn, value)
+			} else if b.isTypeParam(field.Type()) {
+				b.gGenericDecode(value, field.Type(), opt)
+				if self.pointer {
+					b.line("%s = &%s", fn, value)
+				} else {
+					b.line("%s = %s", fn, value)
+				}
+			} else {
+				b.line("%s, err := dec.DecodeValue()", value)
+				b.line("if err != nil {")
+				b.line("return err")
+				b.line("}")
+				b.line("")
+				b.line("%s = %s", fn, alias)
+			}
 
 		case *types.Basic:
-			b.line("case %q:", self.name)
-			value := util.GenerateID("value")
+			b.gCase(self.name)
+			value := b.ids.GenerateID("value")
 			alias := value
 			if typ := b.typeString(field.Type(), opt); typ != x.Name() {
 				alias = fmt.Sprintf("%s(%s)", typ, value)
@@ -687,7 +910,11 @@ func (b *Builder) gFieldDecode(fn string, parent, self *FieldTag, obj *types.Str
 
 			switch x.Kind() {
 			case types.String:
-				b.line("%s, err := dec.DecodeString()", value)
+				if b.isNumber(field.Type()) {
+					b.line("%s, err := dec.DecodeNumber()", value)
+				} else {
+					b.line("%s, err := dec.DecodeString()", value)
+				}
 
 			case types.Int:
 				b.line("%s, err := dec.DecodeInt()", value)
@@ -733,10 +960,17 @@ func (b *Builder) gFieldDecode(fn string, parent, self *FieldTag, obj *types.Str
 			b.line("return err")
 			b.line("}")
 			b.line("")
-			b.line("%s.%s = %s", fn, field.Name(), alias)
+
+			if self.pointer {
+				v := b.ids.GenerateID("value")
+				b.line("%s := %s", v, alias)
+				b.line("%s.%s = &%s", fn, field.Name(), v)
+			} else {
+				b.line("%s.%s = %s", fn, field.Name(), alias)
+			}
 
 		default:
-			value := util.GenerateID("value")
+			value := b.ids.GenerateID("value")
 			b.line("%s, err := dec.DecodeValue()", value)
 			b.line("if err != nil {")
 			b.line("return err")
@@ -748,7 +982,10 @@ func (b *Builder) gFieldDecode(fn string, parent, self *FieldTag, obj *types.Str
 }
 
 func (b *Builder) gStructDecode(fn string, parent *FieldTag, obj *types.Struct, opt *option.Option) {
-	object := util.GenerateID("obj")
+	b.enterStruct(obj)
+	defer b.leaveStruct()
+
+	object := b.ids.GenerateID("obj")
 
 	if b.isRoot(fn) {
 		//initialize pointer field
@@ -770,14 +1007,13 @@ func (b *Builder) gStructDecode(fn string, parent *FieldTag, obj *types.Struct,
 		b.line("dec.Next()")
 
 		// empty object
-		b.line("if dec.IsObjectClose() {")
-		b.line("return nil")
-		b.line("} else {")
+		b.line("if !dec.IsObjectClose() {")
 
+		b.pushSeen(obj)
 		b.line("for %s := 1; %s > 0;  {", object, object)
 
 		// read key
-		key := util.GenerateID("key")
+		key := b.ids.GenerateID("key")
 		b.line("%s, err := dec.NextKey()", key)
 		b.line("if err != nil {")
 		b.line("return err")
@@ -811,6 +1047,7 @@ func (b *Builder) gStructDecode(fn string, parent *FieldTag, obj *types.Struct,
 		b.line("}")
 
 		b.line("}")
+		b.popSeen()
 		b.line("}")
 		b.line("}")
 	}
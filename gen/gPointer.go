@@ -5,7 +5,6 @@ import (
 	"go/types"
 
 	"github.com/go-fish/gojson/option"
-	"github.com/go-fish/gojson/util"
 )
 
 func (b *Builder) gPointerEncode(fn string, self *FieldTag, obj *types.Pointer, opt *option.Option) {
@@ -15,7 +14,11 @@ func (b *Builder) gPointerEncode(fn string, self *FieldTag, obj *types.Pointer,
 
 	switch x := obj.Elem().Underlying().(type) {
 	case *types.Struct:
-		b.gStructEncode(fn, self, x, opt)
+		if b.isDelegate(obj.Elem(), true, opt) {
+			b.gDelegateEncode(fn)
+		} else {
+			b.gStructEncode(fn, self, x, opt)
+		}
 
 	case *types.Map:
 		b.gMapEncode(fn, x, opt)
@@ -30,8 +33,12 @@ func (b *Builder) gPointerEncode(fn string, self *FieldTag, obj *types.Pointer,
 		b.gPointerEncode(fn, self, x, opt)
 
 	case *types.Interface:
-		b.line("if err := enc.EncodeValue(%s); err != nil {", fn)
-		b.line("return nil, err")
+		if b.isTypeParam(obj.Elem()) {
+			b.line("if err := enc.EncodeAny(&%s); err != nil {", fn)
+		} else {
+			b.line("if err := enc.EncodeValue(%s); err != nil {", fn)
+		}
+		b.line("return err")
 		b.line("}")
 
 	case *types.Basic:
@@ -42,7 +49,13 @@ func (b *Builder) gPointerEncode(fn string, self *FieldTag, obj *types.Pointer,
 
 		switch x.Kind() {
 		case types.String:
-			b.line("enc.EncodeKeyString(%q, %s)", self.name, alias)
+			if b.isNumber(obj.Elem()) {
+				b.line("if err := enc.EncodeKeyNumber(%q, string(*%s)); err != nil {", self.name, fn)
+				b.line("return err")
+				b.line("}")
+			} else {
+				b.line("enc.EncodeKeyString(%q, %s)", self.name, alias)
+			}
 
 		case types.Int:
 			b.line("enc.EncodeKeyInt(%q, %s)", self.name, alias)
@@ -86,7 +99,7 @@ func (b *Builder) gPointerEncode(fn string, self *FieldTag, obj *types.Pointer,
 
 	default:
 		b.line("if err := enc.EncodeKeyValue(%q, %s); err != nil {", self.name, fn)
-		b.line("return nil, err")
+		b.line("return err")
 		b.line("}")
 	}
 
@@ -101,7 +114,11 @@ func (b *Builder) gPointerDecode(fn string, self *FieldTag, obj *types.Pointer,
 	b.line("")
 	switch x := obj.Elem().Underlying().(type) {
 	case *types.Struct:
-		b.gStructDecode(fn, self, x, opt)
+		if b.isDelegate(obj.Elem(), false, opt) {
+			b.gDelegateDecode(fn)
+		} else {
+			b.gStructDecode(fn, self, x, opt)
+		}
 
 	case *types.Map:
 		b.gMapDecode(fn, x, opt)
@@ -116,16 +133,20 @@ func (b *Builder) gPointerDecode(fn string, self *FieldTag, obj *types.Pointer,
 		b.gPointerDecode(fn, self, x, opt)
 
 	case *types.Interface:
-		value := util.GenerateID("value")
-		b.line("%s, err := dec.DecodeValue()", value)
-		b.line("if err != nil {")
-		b.line("return err")
-		b.line("}")
-		b.line("")
+		value := b.ids.GenerateID("value")
+		if b.isTypeParam(obj.Elem()) {
+			b.gGenericDecode(value, obj.Elem(), opt)
+		} else {
+			b.line("%s, err := dec.DecodeValue()", value)
+			b.line("if err != nil {")
+			b.line("return err")
+			b.line("}")
+			b.line("")
+		}
 		b.line("%s = &(%s)", fn, value)
 
 	case *types.Basic:
-		value := util.GenerateID("value")
+		value := b.ids.GenerateID("value")
 		alias := value
 		if typ := b.typeString(obj.Elem(), opt); typ != x.Name() {
 			alias = fmt.Sprintf("%s(%s)", typ, value)
@@ -133,7 +154,11 @@ func (b *Builder) gPointerDecode(fn string, self *FieldTag, obj *types.Pointer,
 
 		switch x.Kind() {
 		case types.String:
-			b.line("%s, err := dec.DecodeString()", value)
+			if b.isNumber(obj.Elem()) {
+				b.line("%s, err := dec.DecodeNumber()", value)
+			} else {
+				b.line("%s, err := dec.DecodeString()", value)
+			}
 
 		case types.Int:
 			b.line("%s, err := dec.DecodeInt()", value)
@@ -181,7 +206,7 @@ func (b *Builder) gPointerDecode(fn string, self *FieldTag, obj *types.Pointer,
 		b.line("")
 
 		if alias != value {
-			v := util.GenerateID("value")
+			v := b.ids.GenerateID("value")
 			b.line("%s := %s", v, alias)
 			b.line("%s = &(%s)", fn, v)
 		} else {
@@ -189,7 +214,7 @@ func (b *Builder) gPointerDecode(fn string, self *FieldTag, obj *types.Pointer,
 		}
 
 	default:
-		value := util.GenerateID("value")
+		value := b.ids.GenerateID("value")
 		b.line("%s, err := dec.DecodeValue()", value)
 		b.line("if err != nil {")
 		b.line("return err")
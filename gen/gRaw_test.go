@@ -0,0 +1,7 @@
+package gen
+
+import "testing"
+
+func TestGenerateRaw(t *testing.T) {
+	testPackage(t, "raw", nil)
+}
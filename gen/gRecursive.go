@@ -0,0 +1,28 @@
+package gen
+
+import "go/types"
+
+// isRecursive reports whether the struct of typ is already being generated up the stack,
+// values of recursive types delegate to the generated methods of their named types instead of being inlined.
+func (b *Builder) isRecursive(typ types.Type) bool {
+	obj, ok := typ.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	for _, s := range b.structs {
+		if s == obj || types.Identical(s, obj) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *Builder) enterStruct(obj *types.Struct) {
+	b.structs = append(b.structs, obj)
+}
+
+func (b *Builder) leaveStruct() {
+	b.structs = b.structs[:len(b.structs)-1]
+}
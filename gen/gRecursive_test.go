@@ -0,0 +1,37 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateRecursive(t *testing.T) {
+	testPackage(t, "recursive", nil)
+}
+
+func TestGenerateRecursiveTypes(t *testing.T) {
+	testPackage(t, "tree", func(opt *option.Option) {
+		opt.Types = []string{"Doc", "Node"}
+	})
+}
+
+func TestBuildRecursiveUnselected(t *testing.T) {
+	opt, err := option.NewOption()
+	if !assert.Nil(t, err, "Err must be nil") {
+		return
+	}
+
+	opt.Input = "testdata/tree"
+	opt.Types = []string{"Doc"}
+
+	if !assert.Nil(t, opt.ParsePackage(), "Err must be nil") {
+		return
+	}
+
+	_, err = Build(opt)
+	if assert.NotNil(t, err, "Err must not be nil for recursive types not generated") {
+		assert.Contains(t, err.Error(), "tree.Node", "Err must name the recursive type")
+	}
+}
@@ -9,40 +9,102 @@ import (
 
 func (b *Builder) gStructEncodeWarp(fn string, obj *types.Struct, opt *option.Option) error {
 	sn := strings.ToLower(fn[:1])
-	b.line("func (%s *%s) MarshalJSON() ([]byte, error) {", sn, fn)
+	recv := fn + b.typeParams(fn, opt)
+
+	b.line("func (%s *%s) MarshalJSON() ([]byte, error) {", sn, recv)
 	b.line("enc := backend.NewEncoder()")
+	b.line("if err := %s.EncodeTo(enc); err != nil {", sn)
+	b.line("enc.Release()")
+	b.line("return nil, err")
+	b.line("}")
 	b.line("")
-	b.gStructEncode(sn, new(FieldTag), obj, opt)
-	b.line("data := enc.Bytes()")
+	// the buffer of encoder is reused by others after Release
+	b.line("data := make([]byte, enc.Len())")
+	b.line("copy(data, enc.Bytes())")
 	b.line("enc.Release()")
 	b.line("")
 	b.line("return data, nil")
 	b.line("}")
 	b.line("")
-	return nil
+
+	b.line("func (%s *%s) AppendJSON(dst []byte) ([]byte, error) {", sn, recv)
+	b.line("enc := backend.NewEncoderBuffer(dst)")
+	b.line("if err := %s.EncodeTo(enc); err != nil {", sn)
+	b.line("enc.Release()")
+	b.line("return dst, err")
+	b.line("}")
+	b.line("")
+	b.line("dst = enc.Bytes()")
+	b.line("enc.Release()")
+	b.line("")
+	b.line("return dst, nil")
+	b.line("}")
+	b.line("")
+
+	// EncodeTo encodes into the encoder of caller, it is called by the code generated for other types
+	b.line("func (%s *%s) EncodeTo(enc *backend.Encoder) error {", sn, recv)
+	b.gStructEncode(sn, new(FieldTag), obj, opt)
+	b.line("")
+	b.line("return nil")
+	b.line("}")
+	b.line("")
+	return b.err
 }
 
 func (b *Builder) gStructDecodeWarp(fn string, obj *types.Struct, opt *option.Option) error {
 	sn := strings.ToLower(fn[:1])
-	b.line("func (%s *%s) UnmarshalJSON(data []byte) error {", sn, fn)
+	recv := fn + b.typeParams(fn, opt)
+
+	b.line("func (%s *%s) UnmarshalJSON(data []byte) error {", sn, recv)
 	b.line("if len(data) == 0 {")
 	b.line("return nil")
 	b.line("}")
 	b.line("")
 	b.line("dec := backend.NewDecoder()")
 
+	// check the size before the data is copied
+	b.line("if err := dec.CheckLength(len(data)); err != nil {")
+	b.line("dec.Release()")
+	b.line("return err")
+	b.line("}")
+	b.line("")
+
 	if opt.Unsafe {
 		b.line("dec.SetUnsafeData(data)")
 	} else {
 		b.line("dec.SetData(data)")
 	}
 
+	switch opt.Number {
+	case option.UseNumber:
+		b.line("dec.UseNumber()")
+
+	case option.UseInt64:
+		b.line("dec.UseInt64()")
+	}
+
 	b.line("")
-	b.gStructDecode(sn, new(FieldTag), obj, opt)
+	b.line("err := %s.DecodeFrom(dec)", sn)
+	b.line("if err == nil {")
+	b.line("err = dec.CheckEnd()")
+	b.line("}")
 	b.line("dec.Release()")
 	b.line("")
+	b.line("return err")
+	b.line("}")
+	b.line("")
+
+	// DecodeFrom decodes the next value of the decoder of caller, it is called by the code generated for other types
+	b.line("func (%s *%s) DecodeFrom(dec *backend.Decoder) error {", sn, recv)
+	b.line("if err := dec.Enter(); err != nil {")
+	b.line("return err")
+	b.line("}")
+	b.line("")
+	b.gStructDecode(sn, new(FieldTag), obj, opt)
+	b.line("dec.Leave()")
+	b.line("")
 	b.line("return nil")
 	b.line("}")
 	b.line("")
-	return nil
+	return b.err
 }
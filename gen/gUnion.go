@@ -0,0 +1,221 @@
+package gen
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/go-fish/gojson/option"
+)
+
+// Union is an interface type registered with a //gojson:union directive, eg:
+//
+//	//gojson:union key=type Foo=*FooEvent Bar=*BarEvent
+//	type Event interface{ ... }
+//
+// values are encoded as objects of the concrete type with the key field set to the tag of the type.
+type Union struct {
+	name    string
+	key     string
+	typ     types.Type
+	members []*UnionMember
+}
+
+type UnionMember struct {
+	tag string
+	typ types.Type
+}
+
+// elem returns the type of member without pointer.
+func (m *UnionMember) elem() types.Type {
+	if ptr, ok := m.typ.(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+
+	return m.typ
+}
+
+func (b *Builder) parseUnions(opt *option.Option) error {
+	b.unions = make(map[string]*Union)
+
+	for _, name := range opt.Pkg.Scope().Names() {
+		args, ok := opt.Directive(name, "union")
+		if !ok {
+			continue
+		}
+
+		obj := opt.Pkg.Scope().Lookup(name)
+		iface, ok := obj.Type().Underlying().(*types.Interface)
+		if !ok {
+			return fmt.Errorf("Invalid union %s, only interface can be union", name)
+		}
+
+		u := &Union{name: name, key: "type", typ: obj.Type()}
+
+		for _, arg := range args {
+			kv := strings.SplitN(arg, "=", 2)
+			if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+				return fmt.Errorf("Invalid union %s, bad argument %s", name, arg)
+			}
+
+			if kv[0] == "key" {
+				u.key = kv[1]
+				continue
+			}
+
+			member := opt.Pkg.Scope().Lookup(strings.TrimPrefix(kv[1], "*"))
+			if member == nil {
+				return fmt.Errorf("Invalid union %s, unknown type %s", name, kv[1])
+			}
+
+			typ := member.Type()
+			if strings.HasPrefix(kv[1], "*") {
+				typ = types.NewPointer(typ)
+			}
+
+			if !types.Implements(typ, iface) {
+				return fmt.Errorf("Invalid union %s, %s does not implement %s", name, kv[1], name)
+			}
+
+			u.members = append(u.members, &UnionMember{kv[0], typ})
+		}
+
+		if len(u.members) == 0 {
+			return fmt.Errorf("Invalid union %s, no types registered", name)
+		}
+
+		b.unions[name] = u
+	}
+
+	return nil
+}
+
+func (b *Builder) union(typ types.Type, opt *option.Option) *Union {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || !opt.IsLocal(named.Obj().Pkg()) {
+		return nil
+	}
+
+	return b.unions[named.Obj().Name()]
+}
+
+// hasKey reports whether the struct of typ already encodes the key field itself.
+func (b *Builder) hasKey(typ types.Type, key string) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	obj, ok := typ.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	for _, k := range b.getKeys(obj) {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *Builder) gUnionEncode(fn string, u *Union, opt *option.Option) {
+	value := b.ids.GenerateID("value")
+
+	b.line("switch %s := %s.(type) {", value, fn)
+	b.line("case nil:")
+	b.line("enc.WriteNull()")
+
+	for _, m := range u.members {
+		_, pointer := m.typ.(*types.Pointer)
+
+		b.line("case %s:", b.typeString(m.typ, opt))
+		if pointer {
+			b.line("if %s == nil {", value)
+			b.line("enc.WriteNull()")
+			b.line("} else {")
+		}
+
+		if b.hasGoJSON(m.elem(), true, opt) {
+			if b.hasKey(m.typ, u.key) {
+				b.gDelegateEncode(value)
+			} else {
+				offset := b.ids.GenerateID("offset")
+				b.line("%s := enc.BeginTagged(%q, %q)", offset, u.key, m.tag)
+				b.gDelegateEncode(value)
+				b.line("enc.EndTagged(%s)", offset)
+			}
+		} else {
+			data := b.ids.GenerateID("data")
+			b.line("%s, err := %s.MarshalJSON()", data, value)
+			b.line("if err != nil {")
+			b.line("return err")
+			b.line("}")
+			b.line("")
+
+			if b.hasKey(m.typ, u.key) {
+				b.line("enc.WriteBytes(%s)", data)
+			} else {
+				b.line("enc.EncodeTagged(%q, %q, %s)", u.key, m.tag, data)
+			}
+		}
+
+		if pointer {
+			b.line("}")
+		}
+	}
+
+	b.line("default:")
+	b.line("return fmt.Errorf(\"Unsupported type %%T of union %s\", %s)", u.name, value)
+	b.line("}")
+}
+
+// gUnionDecode declares value with the union type and decodes into it.
+func (b *Builder) gUnionDecode(value string, u *Union, opt *option.Option) {
+	b.line("var %s %s", value, b.typeString(u.typ, opt))
+	b.line("if char := dec.NextChar(); char == 'n' {")
+	b.line("if err := dec.AssetNull(); err != nil {")
+	b.line("return err")
+	b.line("}")
+	b.line("} else {")
+
+	tag := b.ids.GenerateID("tag")
+	b.line("%s, err := dec.PeekString(%q)", tag, u.key)
+	b.line("if err != nil {")
+	b.line("return err")
+	b.line("}")
+	b.line("")
+
+	b.line("switch %s {", tag)
+	for _, m := range u.members {
+		elem := b.ids.GenerateID("elem")
+
+		b.line("case %q:", m.tag)
+		if ptr, ok := m.typ.(*types.Pointer); ok {
+			b.line("%s := new(%s)", elem, b.typeString(ptr.Elem(), opt))
+		} else {
+			b.line("var %s %s", elem, b.typeString(m.typ, opt))
+		}
+
+		if b.hasGoJSON(m.elem(), false, opt) {
+			b.gDelegateDecode(elem)
+		} else {
+			data := b.ids.GenerateID("data")
+			b.line("%s, err := dec.ReadObject()", data)
+			b.line("if err != nil {")
+			b.line("return err")
+			b.line("}")
+			b.line("")
+			b.line("if err := %s.UnmarshalJSON(%s); err != nil {", elem, data)
+			b.line("return err")
+			b.line("}")
+		}
+		b.line("")
+		b.line("%s = %s", value, elem)
+	}
+
+	b.line("default:")
+	b.line("return fmt.Errorf(\"Unknown %s %%q of union %s at pos %%d\", %s, dec.Cursor())", u.key, u.name, tag)
+	b.line("}")
+	b.line("}")
+}
@@ -0,0 +1,7 @@
+package gen
+
+import "testing"
+
+func TestGenerateUnion(t *testing.T) {
+	testPackage(t, "union", nil)
+}
@@ -6,9 +6,12 @@ import (
 	"go/types"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/alecthomas/template"
 	"github.com/go-fish/gojson/option"
+	"github.com/go-fish/gojson/util"
 	"github.com/ttacon/chalk"
 	"golang.org/x/tools/imports"
 )
@@ -17,9 +20,33 @@ type Builder struct {
 	Package string
 	Body    *bytes.Buffer
 	Imports map[string]string
+
+	unions map[string]*Union
+	enums  map[string]*Enum
+
+	naming  string
+	namings map[*types.Var]string
+
+	structs []*types.Struct
+	seens   []*seenSet
+
+	// err is the first error found while generating the methods of a type, eg: recursive types not generated
+	err error
+
+	ids *util.IDGenerator
 }
 
 func Generate(opt *option.Option) error {
+	out, err := Build(opt)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(opt.Output, out)
+}
+
+// Build generates the code of package in opt and returns it without writing the output file.
+func Build(opt *option.Option) ([]byte, error) {
 	b := new(Builder)
 	b.Package = opt.Pkg.Name()
 	b.Imports = map[string]string{
@@ -27,29 +54,64 @@ func Generate(opt *option.Option) error {
 		"errors":  "github.com/go-fish/gojson/errors",
 	}
 	b.Body = bytes.NewBuffer(make([]byte, 0, 4096))
+	b.ids = util.NewIDGenerator(1)
+
+	if err := b.parseUnions(opt); err != nil {
+		return nil, err
+	}
+
+	if err := b.parseEnums(opt); err != nil {
+		return nil, err
+	}
+
+	if err := b.parseNamings(opt); err != nil {
+		return nil, err
+	}
+
+	b.gEnumTables(opt)
+
+	// types of -type may be declared in other packages, but at least one is declared here
+	found := false
+	for _, name := range opt.Types {
+		scope := opt.Pkg.Scope().Lookup(name)
+		if scope == nil {
+			continue
+		}
+
+		if _, ok := scope.Type().Underlying().(*types.Struct); !ok {
+			return nil, fmt.Errorf("Unsupported type %s, only struct can be generated", name)
+		}
+
+		found = true
+	}
+
+	if len(opt.Types) > 0 && !found {
+		return nil, fmt.Errorf("Unknown types %s", strings.Join(opt.Types, ","))
+	}
 
 	for _, name := range opt.Pkg.Scope().Names() {
 		scope := opt.Pkg.Scope().Lookup(name)
 
 		// check obj type
 		if obj, ok := scope.Type().Underlying().(*types.Struct); ok {
-			if !scope.Exported() {
-				fmt.Fprintf(os.Stdout, chalk.Red.Color("Ignore object %s because of unexported\n"), scope.Name())
+			mode := opt.TypeMode(name)
+			if mode == option.None {
+				fmt.Fprintf(os.Stdout, chalk.Red.Color("Ignore object %s because of unselected\n"), scope.Name())
 				continue
 			}
 
 			fmt.Fprintf(os.Stdout, chalk.Green.Color("Begin to generate object %s\n"), scope.Name())
 
 			// check mode
-			if opt.IsEncode() {
+			if mode.IsEncode() {
 				if err := b.gStructEncodeWarp(name, obj, opt); err != nil {
-					return err
+					return nil, err
 				}
 			}
 
-			if opt.IsDecode() {
+			if mode.IsDecode() {
 				if err := b.gStructDecodeWarp(name, obj, opt); err != nil {
-					return err
+					return nil, err
 				}
 			}
 		}
@@ -57,19 +119,39 @@ func Generate(opt *option.Option) error {
 
 	tmpl, err := template.New("gojson").Parse(gojson)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	w := bytes.NewBuffer(make([]byte, 0, 4096))
 	if err := tmpl.Execute(w, b); err != nil {
-		return err
+		return nil, err
 	}
 
-	out, err := imports.Process(opt.Output, w.Bytes(), nil)
+	return imports.Process(opt.Output, w.Bytes(), nil)
+}
+
+// writeFile replaces the file atomically, the original file is left untouched on failure.
+func writeFile(name string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(name), "."+filepath.Base(name)+".*")
 	if err != nil {
 		return err
 	}
 
-	// flush to file
-	return ioutil.WriteFile(opt.Output, out, 0600)
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := os.Rename(f.Name(), name); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return nil
 }
@@ -1,27 +1,116 @@
 package gen
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/go-fish/gojson/option"
+	"github.com/stretchr/testify/assert"
 )
 
+// TestGenerate checks that the generated code of the benchmark is up to date.
 func TestGenerate(t *testing.T) {
 	opt, err := option.NewOption()
-	if err != nil {
-		panic(err)
+	if !assert.Nil(t, err, "Err must be nil") {
+		return
 	}
 
-	opt.Input = "../benchmark/test/test.go"
-	opt.Output = "../benchmark/test/test.generate.go"
-	opt.Mode = 4
-	opt.Unsafe = true
+	opt.Input = "../benchmark"
+	opt.Output = "example.generate.go"
 
-	if err := opt.ParsePackage(); err != nil {
-		panic(err)
+	if !assert.Nil(t, opt.ParsePackage(), "Err must be nil") {
+		return
 	}
 
-	if err := Generate(opt); err != nil {
-		panic(err)
+	out, err := Build(opt)
+	if !assert.Nil(t, err, "Err must be nil") {
+		return
 	}
+
+	data, err := ioutil.ReadFile(opt.Output)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, string(data), string(out), "generated code must be equal to the value expected")
+}
+
+func TestBuildTypes(t *testing.T) {
+	opt, err := option.NewOption()
+	if !assert.Nil(t, err, "Err must be nil") {
+		return
+	}
+
+	opt.Input = "testdata/recursive"
+	opt.Types = []string{"Node"}
+
+	if !assert.Nil(t, opt.ParsePackage(), "Err must be nil") {
+		return
+	}
+
+	out, err := Build(opt)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Contains(t, string(out), "func (n *Node) MarshalJSON()", "Node must be generated")
+	assert.NotContains(t, string(out), "func (p *Person) MarshalJSON()", "Person must not be generated")
+
+	opt.Types = []string{"Missing"}
+	_, err = Build(opt)
+	assert.NotNil(t, err, "Err must not be nil for unknown types")
+}
+
+// testPackage generates the package in testdata/name and runs its tests with the output
+// in an overlay, so the generated code is compiled and checked without writing testdata.
+func testPackage(t *testing.T, name string, setup func(opt *option.Option)) {
+	testPackages(t, []string{name}, setup)
+}
+
+// testPackages is testPackage for several packages generated in one run, eg: packages using the types of each other.
+func testPackages(t *testing.T, names []string, setup func(opt *option.Option)) {
+	opt, err := option.NewOption()
+	if !assert.Nil(t, err, "Err must be nil") {
+		return
+	}
+
+	inputs := make([]string, len(names))
+	for i, name := range names {
+		inputs[i] = "./" + path.Join("testdata", name)
+	}
+
+	if setup != nil {
+		setup(opt)
+	}
+
+	opts, err := opt.ParsePackages(inputs...)
+	if !assert.Nil(t, err, "Err must be nil") {
+		return
+	}
+
+	dir := t.TempDir()
+	files := make(map[string]string, len(opts))
+
+	for i, o := range opts {
+		if !assert.Nil(t, o.Err, "Err must be nil") {
+			return
+		}
+
+		out, err := Build(o)
+		if !assert.Nil(t, err, "Err must be nil") {
+			return
+		}
+
+		generated := filepath.Join(dir, fmt.Sprintf("%d_%s", i, filepath.Base(o.Output)))
+		assert.Nil(t, ioutil.WriteFile(generated, out, 0644), "Err must be nil")
+		files[o.Output] = generated
+	}
+
+	overlay := filepath.Join(dir, "overlay.json")
+	replace, _ := json.Marshal(map[string]map[string]string{"Replace": files})
+	assert.Nil(t, ioutil.WriteFile(overlay, replace, 0644), "Err must be nil")
+
+	args := append([]string{"test", "-overlay", overlay, "-tags", opt.Tags}, inputs...)
+	result, err := exec.Command("go", args...).CombinedOutput()
+	assert.Nil(t, err, "Tests of %s must pass:\n%s", strings.Join(names, ","), result)
 }
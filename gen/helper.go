@@ -23,7 +23,7 @@ func (b *Builder) parseFieldTag(tag string, field *types.Var) *FieldTag {
 	var ft FieldTag
 
 	ft.inline = field.Anonymous()
-	ft.name = field.Name()
+	ft.name = b.fieldName(field)
 	ft.ignore = !field.Exported()
 
 	v, ok := reflect.StructTag(strings.Trim(tag, "`")).Lookup("json")
@@ -95,22 +95,18 @@ func (b *Builder) getKeys(obj *types.Struct) []string {
 
 func (b *Builder) typeString(typ types.Type, opt *option.Option) string {
 	switch x := typ.(type) {
+	case *types.Alias:
+		return b.typeString(types.Unalias(x), opt)
+
 	case *types.Named:
 		if opt.IsLocal(x.Obj().Pkg()) {
-			return x.Obj().Name()
+			return x.Obj().Name() + b.typeArgs(x.TypeArgs(), opt)
 		}
 
-		// add import
-		if !opt.IsLocal(x.Obj().Pkg()) {
-			pkg := x.Obj().Pkg().Path()
-			if index := strings.Index(pkg, "/vendor/"); index > 0 {
-				pkg = pkg[index+8:]
-			}
+		return fmt.Sprintf("%s.%s%s", b.importName(x.Obj().Pkg()), x.Obj().Name(), b.typeArgs(x.TypeArgs(), opt))
 
-			b.Imports[x.Obj().Pkg().Name()] = pkg
-		}
-
-		return fmt.Sprintf("%s.%s", x.Obj().Pkg().Name(), x.Obj().Name())
+	case *types.TypeParam:
+		return x.Obj().Name()
 
 	case *types.Basic:
 		return x.Name()
@@ -132,6 +128,43 @@ func (b *Builder) typeString(typ types.Type, opt *option.Option) string {
 	}
 }
 
+func (b *Builder) isNamed(typ types.Type, path, name string) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path() == path && named.Obj().Name() == name
+}
+
+// importName adds pkg to imports and returns the name it is referred by in generated code,
+// packages sharing a name with another import are aliased, eg: yaml1.
+func (b *Builder) importName(pkg *types.Package) string {
+	name := pkg.Name()
+
+	for i := 1; ; i++ {
+		path, ok := b.Imports[name]
+		if !ok {
+			b.Imports[name] = pkg.Path()
+			return name
+		}
+
+		if path == pkg.Path() {
+			return name
+		}
+
+		name = fmt.Sprintf("%s%d", pkg.Name(), i)
+	}
+}
+
+func (b *Builder) isRawMessage(typ types.Type) bool {
+	return b.isNamed(typ, "github.com/go-fish/gojson", "RawMessage")
+}
+
+func (b *Builder) isNumber(typ types.Type) bool {
+	return b.isNamed(typ, "github.com/go-fish/gojson/backend", "Number")
+}
+
 // init all pointer field at once
 func (b *Builder) initPointerField(fn string, obj *types.Struct, opt *option.Option) {
 	for i := 0; i < obj.NumFields(); i++ {
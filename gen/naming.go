@@ -0,0 +1,116 @@
+package gen
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+	"unicode"
+
+	"github.com/go-fish/gojson/option"
+)
+
+// namings are the strategies to derive keys of fields without json tags.
+var namings = map[string]func(words []string) string{
+	"snake": func(words []string) string {
+		return strings.ToLower(strings.Join(words, "_"))
+	},
+	"kebab": func(words []string) string {
+		return strings.ToLower(strings.Join(words, "-"))
+	},
+	"camel": func(words []string) string {
+		for i, w := range words {
+			w = strings.ToLower(w)
+			if i > 0 {
+				w = strings.ToUpper(w[:1]) + w[1:]
+			}
+
+			words[i] = w
+		}
+
+		return strings.Join(words, "")
+	},
+	"lower": func(words []string) string {
+		return strings.ToLower(strings.Join(words, ""))
+	},
+}
+
+// parseNamings collects the strategies of structs with a //gojson:naming directive.
+func (b *Builder) parseNamings(opt *option.Option) error {
+	if _, ok := namings[opt.Naming]; !ok && opt.Naming != "" {
+		return fmt.Errorf("Unsupported naming %s", opt.Naming)
+	}
+
+	b.naming = opt.Naming
+	b.namings = make(map[*types.Var]string)
+
+	for _, name := range opt.Pkg.Scope().Names() {
+		args, ok := opt.Directive(name, "naming")
+		if !ok {
+			continue
+		}
+
+		obj, ok := opt.Pkg.Scope().Lookup(name).Type().Underlying().(*types.Struct)
+		if !ok {
+			return fmt.Errorf("Invalid naming of %s, only struct can have naming", name)
+		}
+
+		if len(args) != 1 || namings[args[0]] == nil {
+			return fmt.Errorf("Invalid naming of %s, use one of snake, camel, kebab, lower", name)
+		}
+
+		for i := 0; i < obj.NumFields(); i++ {
+			b.namings[obj.Field(i)] = args[0]
+		}
+	}
+
+	return nil
+}
+
+// fieldName returns the key of field which has no name in json tag.
+func (b *Builder) fieldName(field *types.Var) string {
+	naming := b.naming
+	if n, ok := b.namings[field]; ok {
+		naming = n
+	}
+
+	fn, ok := namings[naming]
+	if !ok {
+		return field.Name()
+	}
+
+	return fn(splitWords(field.Name()))
+}
+
+// splitWords splits a Go identifier into words, eg: HTTPServerID => HTTP, Server, ID.
+func splitWords(name string) []string {
+	runes := []rune(name)
+	words := make([]string, 0, 4)
+	begin := 0
+
+	for i := 1; i < len(runes); i++ {
+		prev, cur := runes[i-1], runes[i]
+
+		switch {
+		case cur == '_':
+			if begin < i {
+				words = append(words, string(runes[begin:i]))
+			}
+
+			begin = i + 1
+
+		case unicode.IsUpper(cur) && (unicode.IsLower(prev) || unicode.IsDigit(prev)),
+			unicode.IsUpper(cur) && unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+			if begin < i {
+				words = append(words, string(runes[begin:i]))
+			}
+
+			begin = i
+		}
+	}
+
+	if begin < len(runes) {
+		words = append(words, string(runes[begin:]))
+	}
+
+	return words
+}
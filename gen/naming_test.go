@@ -0,0 +1,31 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitWords(t *testing.T) {
+	assert.Equal(t, []string{"Name"}, splitWords("Name"), "words must be equal to the value expected")
+	assert.Equal(t, []string{"User", "ID"}, splitWords("UserID"), "words must be equal to the value expected")
+	assert.Equal(t, []string{"HTTP", "Server", "Addr"}, splitWords("HTTPServerAddr"), "words must be equal to the value expected")
+	assert.Equal(t, []string{"Field2", "Name"}, splitWords("Field2Name"), "words must be equal to the value expected")
+	assert.Equal(t, []string{"Snake", "Case"}, splitWords("Snake_Case"), "words must be equal to the value expected")
+}
+
+func TestNamings(t *testing.T) {
+	words := func() []string { return splitWords("HTTPServerID") }
+
+	assert.Equal(t, "http_server_id", namings["snake"](words()), "name must be equal to the value expected")
+	assert.Equal(t, "http-server-id", namings["kebab"](words()), "name must be equal to the value expected")
+	assert.Equal(t, "httpServerId", namings["camel"](words()), "name must be equal to the value expected")
+	assert.Equal(t, "httpserverid", namings["lower"](words()), "name must be equal to the value expected")
+}
+
+func TestGenerateNaming(t *testing.T) {
+	testPackage(t, "naming", func(opt *option.Option) {
+		opt.Naming = "camel"
+	})
+}
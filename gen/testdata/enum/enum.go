@@ -0,0 +1,42 @@
+package enum
+
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+)
+
+func (c Color) String() string {
+	switch c {
+	case Red:
+		return "red"
+
+	case Green:
+		return "green"
+
+	case Blue:
+		return "blue"
+
+	default:
+		return "unknown"
+	}
+}
+
+//gojson:enum trimprefix=Status
+type Status uint8
+
+const (
+	StatusActive Status = iota + 1
+	StatusClosed
+)
+
+type Palette struct {
+	Primary  Color            `json:"primary"`
+	Colors   []Color          `json:"colors"`
+	Fixed    [2]Color         `json:"fixed"`
+	ByName   map[string]Color `json:"by_name"`
+	ByStatus map[Status]int   `json:"by_status"`
+	Status   Status           `json:"status"`
+}
@@ -0,0 +1,49 @@
+package enum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPalette(t *testing.T) {
+	p := Palette{
+		Primary:  Green,
+		Colors:   []Color{Red, Blue},
+		Fixed:    [2]Color{Blue, Green},
+		ByName:   map[string]Color{"sky": Blue},
+		ByStatus: map[Status]int{StatusClosed: 2},
+		Status:   StatusActive,
+	}
+
+	data, err := p.MarshalJSON()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"primary":"green","colors":["red","blue"],"fixed":["blue","green"],"by_name":{"sky":"blue"},"by_status":{"Closed":2},"status":"Active"}`,
+		string(data), "data must be equal to the value expected")
+
+	var q Palette
+	assert.Nil(t, q.UnmarshalJSON(data), "Err must be nil")
+	assert.Equal(t, p, q, "palette must be equal to the value expected")
+}
+
+func TestPaletteUnknown(t *testing.T) {
+	inputs := []string{
+		`{"primary":"purple"}`,
+		`{"colors":["red","purple"]}`,
+		`{"fixed":["purple"]}`,
+		`{"by_name":{"sky":"purple"}}`,
+		`{"by_status":{"Open":1}}`,
+		`{"status":"Open"}`,
+	}
+
+	for _, input := range inputs {
+		var p Palette
+		assert.NotNil(t, p.UnmarshalJSON([]byte(input)), "Err must not be nil for %s", input)
+	}
+
+	_, err := (&Palette{Primary: Color(7)}).MarshalJSON()
+	assert.EqualError(t, err, "unknown Color value 7", "Err must be equal to the value expected for unknown values")
+
+	_, err = (&Palette{ByStatus: map[Status]int{Status(7): 1}}).MarshalJSON()
+	assert.EqualError(t, err, "unknown Status value 7", "Err must be equal to the value expected for unknown keys")
+}
@@ -0,0 +1,20 @@
+package generic
+
+//gojson:naming snake
+type Item struct {
+	ItemID int
+	Title  string
+}
+
+type Page[T any] struct {
+	Items  []T          `json:"items"`
+	First  T            `json:"first"`
+	Last   *T           `json:"last"`
+	ByName map[string]T `json:"by_name"`
+	Total  int          `json:"total"`
+}
+
+type Catalog struct {
+	Items  Page[Item] `json:"items"`
+	Counts Page[int]  `json:"counts"`
+}
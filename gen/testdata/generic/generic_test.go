@@ -0,0 +1,44 @@
+package generic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPage(t *testing.T) {
+	p := Page[Item]{
+		Items:  []Item{{1, "a"}, {2, "b"}},
+		First:  Item{1, "a"},
+		Last:   &Item{2, "b"},
+		ByName: map[string]Item{"c": {3, "c"}},
+		Total:  2,
+	}
+
+	data, err := p.MarshalJSON()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"items":[{"item_id":1,"title":"a"},{"item_id":2,"title":"b"}],"first":{"item_id":1,"title":"a"},`+
+		`"last":{"item_id":2,"title":"b"},"by_name":{"c":{"item_id":3,"title":"c"}},"total":2}`,
+		string(data), "data must be equal to the value expected")
+
+	var q Page[Item]
+	assert.Nil(t, q.UnmarshalJSON(data), "Err must be nil")
+	assert.Equal(t, p, q, "page must be equal to the value expected")
+}
+
+func TestCatalog(t *testing.T) {
+	c := Catalog{
+		Items:  Page[Item]{Items: []Item{{1, "a"}}, First: Item{1, "a"}, Total: 1},
+		Counts: Page[int]{Items: []int{1, 2}, First: 1, ByName: map[string]int{"a": 3}, Total: 2},
+	}
+
+	data, err := c.MarshalJSON()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"items":{"items":[{"item_id":1,"title":"a"}],"first":{"item_id":1,"title":"a"},"by_name":null,"total":1},`+
+		`"counts":{"items":[1,2],"first":1,"by_name":{"a":3},"total":2}}`,
+		string(data), "data must be equal to the value expected")
+
+	var d Catalog
+	assert.Nil(t, d.UnmarshalJSON(data), "Err must be nil")
+	assert.Equal(t, c, d, "catalog must be equal to the value expected")
+}
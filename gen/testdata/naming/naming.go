@@ -0,0 +1,15 @@
+package naming
+
+type User struct {
+	UserID   int
+	HTTPAddr string `json:",omitempty"`
+	Email    string `json:"mail"`
+	Settings Settings
+}
+
+//gojson:naming kebab
+type Settings struct {
+	DarkMode  bool
+	PageSize  int
+	FontScale float64 `json:"font_scale"`
+}
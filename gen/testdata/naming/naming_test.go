@@ -0,0 +1,31 @@
+package naming
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUser(t *testing.T) {
+	u := User{
+		UserID:   1,
+		HTTPAddr: "a",
+		Email:    "b",
+		Settings: Settings{DarkMode: true, PageSize: 2, FontScale: 1.5},
+	}
+
+	data, err := u.MarshalJSON()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"userId":1,"httpAddr":"a","mail":"b","settings":{"dark-mode":true,"page-size":2,"font_scale":1.5}}`,
+		string(data), "data must be equal to the value expected")
+
+	var v User
+	assert.Nil(t, v.UnmarshalJSON(data), "Err must be nil")
+	assert.Equal(t, u, v, "user must be equal to the value expected")
+}
+
+func TestUserGoNames(t *testing.T) {
+	var u User
+	assert.Nil(t, u.UnmarshalJSON([]byte(`{"UserID":1,"Settings":{"DarkMode":true}}`)), "Err must be nil")
+	assert.Equal(t, User{}, u, "keys of go names must be ignored")
+}
@@ -0,0 +1,19 @@
+package nested
+
+import "github.com/go-fish/gojson/gen/testdata/nested/inner"
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+type Author struct {
+	Name    string        `json:"name"`
+	Address inner.Address `json:"address"`
+}
+
+type Post struct {
+	Title   string           `json:"title"`
+	Tags    []Tag            `json:"tags"`
+	Author  *Author          `json:"author"`
+	Offices []*inner.Address `json:"offices"`
+}
@@ -0,0 +1,54 @@
+package nested
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson/gen/testdata/nested/inner"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPost(t *testing.T) {
+	p := Post{
+		Title:   "a",
+		Tags:    []Tag{{"b"}, {"c"}},
+		Author:  &Author{Name: "d", Address: inner.Address{Street: "e", City: "f"}},
+		Offices: []*inner.Address{{Street: "g", City: "h"}},
+	}
+
+	data, err := p.MarshalJSON()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"title":"a","tags":[{"name":"b"},{"name":"c"}],"author":{"name":"d","address":{"street":"e","city":"f"}},`+
+		`"offices":[{"street":"g","city":"h"}]}`, string(data), "data must be equal to the value expected")
+
+	var q Post
+	assert.Nil(t, q.UnmarshalJSON(data), "Err must be nil")
+	assert.Equal(t, p, q, "post must be equal to the value expected")
+}
+
+func TestPostAllocs(t *testing.T) {
+	p := Post{
+		Title:   "a",
+		Tags:    []Tag{{"b"}},
+		Author:  &Author{Name: "c", Address: inner.Address{Street: "d", City: "e"}},
+		Offices: []*inner.Address{{Street: "f", City: "g"}},
+	}
+
+	data, err := p.MarshalJSON()
+	if !assert.Nil(t, err, "Err must be nil") {
+		return
+	}
+
+	// nested and foreign types share the encoder of Post, only the returned data is allocated
+	allocs := testing.AllocsPerRun(100, func() {
+		p.MarshalJSON()
+	})
+	assert.Equal(t, float64(1), allocs, "allocs must be equal to the value expected")
+
+	// nested and foreign types share the decoder of Post, the data is copied once, the others are
+	// the slices of tags and offices, the author and the address of office
+	allocs = testing.AllocsPerRun(100, func() {
+		var q Post
+		q.UnmarshalJSON(data)
+	})
+	assert.Equal(t, float64(5), allocs, "allocs must be equal to the value expected")
+}
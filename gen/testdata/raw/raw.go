@@ -0,0 +1,10 @@
+package raw
+
+import "github.com/go-fish/gojson"
+
+type Envelope struct {
+	Kind    string                       `json:"kind"`
+	Payload gojson.RawMessage            `json:"payload"`
+	ByName  map[string]gojson.RawMessage `json:"by_name"`
+	Items   []gojson.RawMessage          `json:"items"`
+}
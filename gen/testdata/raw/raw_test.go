@@ -0,0 +1,31 @@
+package raw
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvelope(t *testing.T) {
+	e := Envelope{
+		Kind:    "a",
+		Payload: gojson.RawMessage(`{"b":[1,2]}`),
+		ByName:  map[string]gojson.RawMessage{"": gojson.RawMessage(`"c"`)},
+		Items:   []gojson.RawMessage{gojson.RawMessage(`1`), gojson.RawMessage(`{"d":null}`)},
+	}
+
+	data, err := e.MarshalJSON()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"kind":"a","payload":{"b":[1,2]},"by_name":{"":"c"},"items":[1,{"d":null}]}`,
+		string(data), "data must be equal to the value expected")
+
+	var f Envelope
+	assert.Nil(t, f.UnmarshalJSON(data), "Err must be nil")
+	assert.Equal(t, e, f, "envelope must be equal to the value expected")
+}
+
+func TestEnvelopeInvalid(t *testing.T) {
+	_, err := (&Envelope{Payload: gojson.RawMessage(`{`)}).MarshalJSON()
+	assert.NotNil(t, err, "Err must not be nil for invalid raw messages")
+}
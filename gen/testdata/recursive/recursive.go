@@ -0,0 +1,18 @@
+package recursive
+
+type Node struct {
+	Name     string           `json:"name"`
+	Next     *Node            `json:"next"`
+	Children []*Node          `json:"children"`
+	ByName   map[string]*Node `json:"by_name"`
+}
+
+type Person struct {
+	Name    string   `json:"name"`
+	Company *Company `json:"company"`
+}
+
+type Company struct {
+	Name      string    `json:"name"`
+	Employees []*Person `json:"employees"`
+}
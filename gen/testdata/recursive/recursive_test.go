@@ -0,0 +1,46 @@
+package recursive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode(t *testing.T) {
+	n := Node{
+		Name:     "a",
+		Next:     &Node{Name: "b", Next: &Node{Name: "c"}},
+		Children: []*Node{{Name: "d", Children: []*Node{{Name: "e"}}}},
+		ByName:   map[string]*Node{"f": {Name: "f", Next: &Node{Name: "g"}}},
+	}
+
+	data, err := n.MarshalJSON()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"name":"a","next":{"name":"b","next":{"name":"c","children":null,"by_name":null},"children":null,"by_name":null},`+
+		`"children":[{"name":"d","children":[{"name":"e","children":null,"by_name":null}],"by_name":null}],`+
+		`"by_name":{"f":{"name":"f","next":{"name":"g","children":null,"by_name":null},"children":null,"by_name":null}}}`,
+		string(data), "data must be equal to the value expected")
+
+	var m Node
+	assert.Nil(t, m.UnmarshalJSON(data), "Err must be nil")
+	assert.Equal(t, n, m, "node must be equal to the value expected")
+}
+
+func TestMutual(t *testing.T) {
+	p := Person{
+		Name: "a",
+		Company: &Company{
+			Name:      "b",
+			Employees: []*Person{{Name: "c", Company: &Company{Name: "d"}}},
+		},
+	}
+
+	data, err := p.MarshalJSON()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"name":"a","company":{"name":"b","employees":[{"name":"c","company":{"name":"d","employees":null}}]}}`,
+		string(data), "data must be equal to the value expected")
+
+	var q Person
+	assert.Nil(t, q.UnmarshalJSON(data), "Err must be nil")
+	assert.Equal(t, p, q, "person must be equal to the value expected")
+}
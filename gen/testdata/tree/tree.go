@@ -0,0 +1,11 @@
+package tree
+
+type Node struct {
+	Name string  `json:"name"`
+	Kids []*Node `json:"kids"`
+}
+
+type Doc struct {
+	Title string `json:"title"`
+	Root  Node   `json:"root"`
+}
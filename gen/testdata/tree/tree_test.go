@@ -0,0 +1,23 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoc(t *testing.T) {
+	d := Doc{
+		Title: "a",
+		Root:  Node{Name: "b", Kids: []*Node{{Name: "c", Kids: []*Node{{Name: "d"}}}}},
+	}
+
+	data, err := d.MarshalJSON()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"title":"a","root":{"name":"b","kids":[{"name":"c","kids":[{"name":"d","kids":null}]}]}}`,
+		string(data), "data must be equal to the value expected")
+
+	var e Doc
+	assert.Nil(t, e.UnmarshalJSON(data), "Err must be nil")
+	assert.Equal(t, d, e, "doc must be equal to the value expected")
+}
@@ -0,0 +1,29 @@
+package union
+
+//gojson:union key=kind circle=*Circle square=Square
+type Shape interface {
+	Area() float64
+}
+
+type Circle struct {
+	Radius float64 `json:"radius"`
+}
+
+func (c *Circle) Area() float64 {
+	return 3 * c.Radius * c.Radius
+}
+
+type Square struct {
+	Kind string  `json:"kind"`
+	Side float64 `json:"side"`
+}
+
+func (s Square) Area() float64 {
+	return s.Side * s.Side
+}
+
+type Drawing struct {
+	Main   Shape            `json:"main"`
+	Shapes []Shape          `json:"shapes"`
+	ByName map[string]Shape `json:"by_name"`
+}
@@ -0,0 +1,59 @@
+package union
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson/backend"
+	"github.com/go-fish/gojson/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawing(t *testing.T) {
+	d := Drawing{
+		Main:   &Circle{Radius: 1},
+		Shapes: []Shape{Square{Kind: "square", Side: 2}, nil},
+		ByName: map[string]Shape{"c": &Circle{Radius: 3}},
+	}
+
+	data, err := d.MarshalJSON()
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"main":{"kind":"circle","radius":1},"shapes":[{"kind":"square","side":2},null],"by_name":{"c":{"kind":"circle","radius":3}}}`,
+		string(data), "data must be equal to the value expected")
+
+	var e Drawing
+	assert.Nil(t, e.UnmarshalJSON(data), "Err must be nil")
+	assert.Equal(t, d, e, "drawing must be equal to the value expected")
+}
+
+func TestDrawingOutOfOrder(t *testing.T) {
+	var d Drawing
+	assert.Nil(t, d.UnmarshalJSON([]byte(`{"main":{"radius":1,"\u006bind":"circle"},"shapes":[{"side":2,"kind":"square"}]}`)), "Err must be nil")
+	assert.Equal(t, Drawing{Main: &Circle{Radius: 1}, Shapes: []Shape{Square{Kind: "square", Side: 2}}}, d, "drawing must be equal to the value expected")
+}
+
+func TestDrawingUnknown(t *testing.T) {
+	inputs := []string{
+		`{"main":{"kind":"triangle","side":1}}`,
+		`{"main":{"radius":1}}`,
+		`{"shapes":[{"kind":1}]}`,
+	}
+
+	for _, input := range inputs {
+		var d Drawing
+		assert.NotNil(t, d.UnmarshalJSON([]byte(input)), "Err must not be nil for %s", input)
+	}
+}
+
+func TestDrawingDuplicate(t *testing.T) {
+	data := []byte(`{"main":{"kind":"square","radius":1,"kind":"circle"}}`)
+
+	var d Drawing
+	assert.Nil(t, d.UnmarshalJSON(data), "Err must be nil")
+	assert.Equal(t, Drawing{Main: &Circle{Radius: 1}}, d, "drawing must be equal to the value expected")
+
+	backend.DefaultDuplicateKeys = backend.RejectDuplicateKeys
+	defer func() { backend.DefaultDuplicateKeys = backend.AllowDuplicateKeys }()
+
+	var e Drawing
+	assert.IsType(t, &errors.DuplicateKeyError{}, e.UnmarshalJSON(data), "Err must be DuplicateKeyError")
+}
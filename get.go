@@ -0,0 +1,144 @@
+package gojson
+
+import (
+	"strings"
+
+	"github.com/go-fish/gojson/backend"
+	"github.com/go-fish/gojson/errors"
+)
+
+// ValueType is the type of a json value returned by Get, see backend.ValueType.
+type ValueType = backend.ValueType
+
+const (
+	NotExist   = backend.NotExist
+	StringType = backend.StringType
+	NumberType = backend.NumberType
+	ObjectType = backend.ObjectType
+	ArrayType  = backend.ArrayType
+	BoolType   = backend.BoolType
+	NullType   = backend.NullType
+)
+
+// Get returns the raw json value at path in data and its type, without decoding data, eg:
+//
+//	value, typ, err := gojson.Get(data, "person", "github", "followers")
+//
+// an element of path is a key of an object, or an index of an array in brackets, eg: "[0]", see backend.Decoder.Seek.
+// the value aliases data, strings keep their quotes and escape sequences.
+func Get(data []byte, path ...string) ([]byte, ValueType, error) {
+	dec := backend.NewDecoder()
+	dec.Reset(data, backend.WithUnsafe())
+	defer dec.Release()
+
+	if err := dec.Seek(path...); err != nil {
+		return nil, NotExist, err
+	}
+
+	typ := dec.ValueType()
+	begin := dec.Cursor()
+
+	if err := dec.SkipValue(); err != nil {
+		return nil, NotExist, err
+	}
+
+	return data[begin:dec.Cursor()], typ, nil
+}
+
+// GetString returns the unescaped string at path in data.
+func GetString(data []byte, path ...string) (string, error) {
+	dec, err := seek(data, StringType, path)
+	if err != nil {
+		return "", err
+	}
+	defer dec.Release()
+
+	raw, err := dec.ReadString()
+	if err != nil {
+		return "", err
+	}
+
+	value, err := backend.Unquote(raw)
+	if err != nil {
+		return "", err
+	}
+
+	return string(value), nil
+}
+
+// GetInt64 returns the int64 at path in data.
+func GetInt64(data []byte, path ...string) (int64, error) {
+	dec, err := seek(data, NumberType, path)
+	if err != nil {
+		return 0, err
+	}
+	defer dec.Release()
+
+	return dec.DecodeInt64()
+}
+
+// GetFloat64 returns the float64 at path in data.
+func GetFloat64(data []byte, path ...string) (float64, error) {
+	dec, err := seek(data, NumberType, path)
+	if err != nil {
+		return 0, err
+	}
+	defer dec.Release()
+
+	return dec.DecodeFloat64()
+}
+
+// GetNumber returns the number at path in data, it keeps the precision of values which do not fit in a float64.
+func GetNumber(data []byte, path ...string) (Number, error) {
+	dec, err := seek(data, NumberType, path)
+	if err != nil {
+		return "", err
+	}
+	defer dec.Release()
+
+	n, err := dec.DecodeNumber()
+	if err != nil {
+		return "", err
+	}
+
+	// the number aliases data
+	return Number(strings.Clone(string(n))), nil
+}
+
+// GetBool returns the bool at path in data.
+func GetBool(data []byte, path ...string) (bool, error) {
+	dec, err := seek(data, BoolType, path)
+	if err != nil {
+		return false, err
+	}
+	defer dec.Release()
+
+	return dec.DecodeBool()
+}
+
+// seek returns a decoder positioned at the value at path in data, a *errors.TypeMismatchError is returned
+// if the value is not of type typ.
+// the decoder must be released by the caller if no error is returned.
+func seek(data []byte, typ ValueType, path []string) (*backend.Decoder, error) {
+	dec := backend.NewDecoder()
+	dec.Reset(data, backend.WithUnsafe())
+
+	if err := dec.Seek(path...); err != nil {
+		dec.Release()
+		return nil, err
+	}
+
+	if actual := dec.ValueType(); actual != typ {
+		var err error
+		if actual == NotExist {
+			err = errors.NewParseError(dec.Char(), dec.Cursor())
+		} else {
+			err = errors.NewTypeMismatchError(typ.String(), actual.String(), dec.Cursor())
+		}
+
+		dec.Release()
+		return nil, err
+	}
+
+	return dec, nil
+}
@@ -0,0 +1,118 @@
+package gojson
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var getData = []byte(`{
+	"person": {
+		"name": "go\"jsoné",
+		"github": {"followers": 1024, "score": 4.5, "id": 12345678901234567890123},
+		"tags": ["a", {"active": true}],
+		"[0]": "key",
+		"ab": null
+	}
+}`)
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		path  []string
+		value string
+		typ   ValueType
+	}{
+		{[]string{"person", "name"}, `"go\"jsoné"`, StringType},
+		{[]string{"person", "github"}, `{"followers": 1024, "score": 4.5, "id": 12345678901234567890123}`, ObjectType},
+		{[]string{"person", "tags"}, `["a", {"active": true}]`, ArrayType},
+		{[]string{"person", "tags", "[1]", "active"}, `true`, BoolType},
+		{[]string{"person", `\[0]`}, `"key"`, StringType},
+		{[]string{"person", "ab"}, `null`, NullType},
+	}
+
+	for _, test := range tests {
+		value, typ, err := Get(getData, test.path...)
+		assert.Nil(t, err, "Err must be nil for %q", test.path)
+		assert.Equal(t, test.value, string(value), "value must be equal to the value expected for %q", test.path)
+		assert.Equal(t, test.typ, typ, "type must be equal to the value expected for %q", test.path)
+	}
+
+	for _, path := range [][]string{{"missing"}, {"person", "tags", "[2]"}, {"person", "[0]"}, {"person", "name", "a"}} {
+		_, typ, err := Get(getData, path...)
+		assert.IsType(t, &errors.KeyPathNotFoundError{}, err, "Err must be a KeyPathNotFoundError for %q", path)
+		assert.Equal(t, NotExist, typ, "type must be equal to the value expected for %q", path)
+	}
+}
+
+func TestGetTyped(t *testing.T) {
+	s, err := GetString(getData, "person", "name")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "go\"jsoné", s, "value must be equal to the value expected")
+
+	s, err = GetString(getData, "person", "tags", "[0]")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "a", s, "value must be equal to the value expected")
+
+	s, err = GetString(getData, "person", `\[0]`)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "key", s, "value must be equal to the value expected")
+
+	i, err := GetInt64(getData, "person", "github", "followers")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, int64(1024), i, "value must be equal to the value expected")
+
+	f, err := GetFloat64(getData, "person", "github", "score")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, 4.5, f, "value must be equal to the value expected")
+
+	n, err := GetNumber(getData, "person", "github", "id")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, Number("12345678901234567890123"), n, "value must be equal to the value expected")
+
+	b, err := GetBool(getData, "person", "tags", "[1]", "active")
+	assert.Nil(t, err, "Err must be nil")
+	assert.True(t, b, "value must be equal to the value expected")
+}
+
+func TestGetTypeMismatch(t *testing.T) {
+	_, err := GetInt64(getData, "person", "name")
+	if assert.IsType(t, &errors.TypeMismatchError{}, err, "Err must be a TypeMismatchError") {
+		assert.Equal(t, "number", err.(*errors.TypeMismatchError).Expected, "expected must be equal to the value expected")
+		assert.Equal(t, "string", err.(*errors.TypeMismatchError).Actual, "actual must be equal to the value expected")
+		assert.Contains(t, err.Error(), "expected number value but found string", "message must name the types")
+	}
+
+	_, err = GetString(getData, "person", "github")
+	assert.IsType(t, &errors.TypeMismatchError{}, err, "Err must be a TypeMismatchError")
+
+	_, err = GetBool(getData, "person", "ab")
+	assert.IsType(t, &errors.TypeMismatchError{}, err, "Err must be a TypeMismatchError")
+
+	_, err = GetFloat64(getData, "person", "tags")
+	assert.IsType(t, &errors.TypeMismatchError{}, err, "Err must be a TypeMismatchError")
+
+	_, err = GetNumber(getData, "person", "tags", "[0]")
+	assert.IsType(t, &errors.TypeMismatchError{}, err, "Err must be a TypeMismatchError")
+}
+
+func TestGetNotFound(t *testing.T) {
+	paths := [][]string{
+		{"person", "tags", "[2]"},
+		{"person", "tags", "[100]"},
+		{"person", "github", "[0]"},
+		{"person", "missing"},
+		{"person", "name", "first"},
+	}
+
+	for _, path := range paths {
+		_, err := GetString(getData, path...)
+		assert.IsType(t, &errors.KeyPathNotFoundError{}, err, "Err must be a KeyPathNotFoundError for %q", path)
+
+		_, err = GetInt64(getData, path...)
+		assert.IsType(t, &errors.KeyPathNotFoundError{}, err, "Err must be a KeyPathNotFoundError for %q", path)
+	}
+
+	_, err := GetInt64([]byte(`{"a": x}`), "a")
+	assert.NotNil(t, err, "Err must not be nil for invalid values")
+}
@@ -7,13 +7,31 @@ import (
 	"github.com/go-fish/gojson/backend"
 )
 
+// Unmarshal decodes data into v, data must hold exactly one json value, eg: {"a":1}garbage is rejected.
 func Unmarshal(data []byte, v interface{}) error {
+	if t, ok := v.(json.Unmarshaler); ok {
+		return t.UnmarshalJSON(data)
+	}
+
+	dec := backend.NewDecoder()
+	defer dec.Release()
+
+	if err := dec.CheckLength(len(data)); err != nil {
+		return err
+	}
+
+	dec.SetData(data)
+
+	if err := decode(dec, v); err != nil {
+		return err
+	}
+
+	return dec.CheckEnd()
+}
+
+func decode(dec *backend.Decoder, v interface{}) error {
 	switch t := v.(type) {
 	case *string:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeString()
 		if err != nil {
 			return err
@@ -22,10 +40,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case *int:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeInt()
 		if err != nil {
 			return err
@@ -34,10 +48,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case *int8:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeInt8()
 		if err != nil {
 			return err
@@ -46,10 +56,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case *int16:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeInt16()
 		if err != nil {
 			return err
@@ -58,10 +64,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case *int32:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeInt32()
 		if err != nil {
 			return err
@@ -70,10 +72,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case *int64:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeInt64()
 		if err != nil {
 			return err
@@ -82,10 +80,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case *uint:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeUint()
 		if err != nil {
 			return err
@@ -94,10 +88,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case *uint8:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeUint8()
 		if err != nil {
 			return err
@@ -106,10 +96,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case *uint16:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeUint16()
 		if err != nil {
 			return err
@@ -118,10 +104,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case *uint32:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeUint32()
 		if err != nil {
 			return err
@@ -130,10 +112,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case *uint64:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeUint64()
 		if err != nil {
 			return err
@@ -141,11 +119,15 @@ func Unmarshal(data []byte, v interface{}) error {
 
 		*t = v
 
-	case *[]byte:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
+	case *Number:
+		v, err := dec.DecodeNumber()
+		if err != nil {
+			return err
+		}
 
+		*t = v
+
+	case *[]byte:
 		v, err := dec.DecodeBytes()
 		if err != nil {
 			return err
@@ -154,10 +136,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case []byte:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeBytes()
 		if err != nil {
 			return err
@@ -166,10 +144,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		t = v
 
 	case *map[string]interface{}:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeObject()
 		if err != nil {
 			return err
@@ -178,10 +152,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case map[string]interface{}:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeObject()
 		if err != nil {
 			return err
@@ -190,10 +160,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		t = v
 
 	case *[]interface{}:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeArray()
 		if err != nil {
 			return err
@@ -202,10 +168,6 @@ func Unmarshal(data []byte, v interface{}) error {
 		*t = v
 
 	case []interface{}:
-		dec := backend.NewDecoder()
-		dec.SetData(data)
-		defer dec.Release()
-
 		v, err := dec.DecodeArray()
 		if err != nil {
 			return err
@@ -213,9 +175,6 @@ func Unmarshal(data []byte, v interface{}) error {
 
 		t = v
 
-	case json.Unmarshaler:
-		return t.UnmarshalJSON(data)
-
 	default:
 		return fmt.Errorf("Unsupported type %T in Unmarshal", v)
 	}
@@ -224,109 +183,94 @@ func Unmarshal(data []byte, v interface{}) error {
 }
 
 func Marshal(v interface{}) ([]byte, error) {
+	enc := backend.NewEncoder()
+	defer enc.Release()
+
+	if err := encode(enc, v); err != nil {
+		return nil, err
+	}
+
+	// the buffer of encoder is reused by others after Release
+	data := make([]byte, enc.Len())
+	copy(data, enc.Bytes())
+
+	return data, nil
+}
+
+// MarshalAppend appends the json encoding of v to dst and returns the extended buffer,
+// types generated by gojson are encoded into dst directly without allocations.
+func MarshalAppend(dst []byte, v interface{}) ([]byte, error) {
+	enc := backend.NewEncoderBuffer(dst)
+	defer enc.Release()
+
+	if err := encode(enc, v); err != nil {
+		return dst, err
+	}
+
+	return enc.Bytes(), nil
+}
+
+func encode(enc *backend.Encoder, v interface{}) error {
 	switch t := v.(type) {
 	case string:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeString(t)
-		return enc.Bytes(), nil
 
 	case int:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeInt(t)
-		return enc.Bytes(), nil
 
 	case int8:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeInt8(t)
-		return enc.Bytes(), nil
 
 	case int16:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeInt16(t)
-		return enc.Bytes(), nil
 
 	case int32:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeInt32(t)
-		return enc.Bytes(), nil
 
 	case int64:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeInt64(t)
-		return enc.Bytes(), nil
 
 	case uint:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeUint(t)
-		return enc.Bytes(), nil
 
 	case uint8:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeUint8(t)
-		return enc.Bytes(), nil
 
 	case uint16:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeUint16(t)
-		return enc.Bytes(), nil
 
 	case uint32:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeUint32(t)
-		return enc.Bytes(), nil
 
 	case uint64:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeUint64(t)
-		return enc.Bytes(), nil
 
-	case []byte:
-		enc := backend.NewEncoder()
-		defer enc.Release()
+	case Number:
+		return enc.EncodeNumber(string(t))
 
+	case []byte:
 		enc.EncodeBytes(t)
-		return enc.Bytes(), nil
 
 	case map[string]interface{}:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeObject(t)
-		return enc.Bytes(), nil
 
 	case []interface{}:
-		enc := backend.NewEncoder()
-		defer enc.Release()
-
 		enc.EncodeArray(t)
-		return enc.Bytes(), nil
+
+	case backend.Marshaler:
+		return t.EncodeTo(enc)
 
 	case json.Marshaler:
-		return t.MarshalJSON()
+		data, err := t.MarshalJSON()
+		if err != nil {
+			return err
+		}
+
+		enc.WriteBytes(data)
 
 	default:
-		return nil, fmt.Errorf("Unsupported type %T in Unmarshal", v)
+		return fmt.Errorf("Unsupported type %T in Marshal", v)
 	}
+
+	return nil
 }
@@ -0,0 +1,55 @@
+package gojson
+
+import (
+	"testing"
+
+	"github.com/go-fish/gojson/backend"
+	"github.com/go-fish/gojson/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalTrailingData(t *testing.T) {
+	var v map[string]interface{}
+
+	err := Unmarshal([]byte(`{"a":1} x`), &v)
+	assert.NotNil(t, err, "Err must not be nil")
+
+	err = Unmarshal([]byte(`{"a":1} `), &v)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, map[string]interface{}{"a": float64(1)}, v, "v must be equal to the value expected")
+
+	var s string
+
+	err = Unmarshal([]byte(`"a\"b" "c"`), &s)
+	assert.NotNil(t, err, "Err must not be nil")
+
+	err = Unmarshal([]byte(`"a\"b\\" `), &s)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `a"b\`, s, "s must be equal to the value expected")
+}
+
+func TestUnmarshalMaxBytes(t *testing.T) {
+	defer func(limits backend.Limits) { backend.DefaultLimits = limits }(backend.DefaultLimits)
+	backend.DefaultLimits = backend.Limits{MaxBytes: 8}
+
+	var (
+		s string
+		a []interface{}
+		m map[string]interface{}
+		i interface{}
+	)
+
+	for _, v := range []interface{}{&s, &a, &m, &i} {
+		err := Unmarshal([]byte(`"abcdefgh"`), v)
+		_, ok := err.(*errors.LimitExceededError)
+		assert.True(t, ok, "Err must be a LimitExceededError for %T", v)
+	}
+
+	err := Unmarshal([]byte(`"abcdef"`), &s)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "abcdef", s, "s must be equal to the value expected")
+
+	err = Unmarshal([]byte(`[1,2,3]`), &a)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, []interface{}{float64(1), float64(2), float64(3)}, a, "a must be equal to the value expected")
+}
@@ -0,0 +1,620 @@
+// Package jsonpath evaluates JSONPath queries, RFC 9535, directly over raw documents.
+// the values are selected with the scanner of the backend, without decoding the document, eg:
+//
+//	matches, err := jsonpath.Find(doc, `$.store.book[?@.price < 10].title`)
+//
+// wildcards, recursive descent, slices, filter expressions and the functions length, count, match, search and value
+// are supported. regular expressions of match and search use the syntax of the regexp package.
+package jsonpath
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-fish/gojson/backend"
+	"github.com/go-fish/gojson/pointer"
+)
+
+// Query is a compiled JSONPath query, it is safe for concurrent use.
+type Query struct {
+	expr     string
+	segments []segment
+}
+
+// Match is a value selected by a query.
+type Match struct {
+	// Path is the normalized path of the value, eg: $['store']['book'][0].
+	Path string
+	// Pointer is the json pointer of the value, eg: /store/book/0.
+	Pointer string
+	// Value is the raw json value, it aliases the document.
+	Value []byte
+}
+
+// Compile parses the JSONPath query expr.
+func Compile(expr string) (*Query, error) {
+	p := &parser{expr: expr}
+
+	segments, err := p.query()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Query{expr, segments}, nil
+}
+
+// MustCompile is like Compile but panics if expr is invalid.
+func MustCompile(expr string) *Query {
+	q, err := Compile(expr)
+	if err != nil {
+		panic(err)
+	}
+
+	return q
+}
+
+func (q *Query) String() string {
+	return q.expr
+}
+
+// Find returns the values of doc selected by expr, see Query.Find.
+func Find(doc []byte, expr string) ([]Match, error) {
+	q, err := Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Find(doc)
+}
+
+// Find returns the values of doc selected by the query in document order, doc is validated first.
+func (q *Query) Find(doc []byte) ([]Match, error) {
+	if err := backend.Validate(doc); err != nil {
+		return nil, err
+	}
+
+	root := &node{index: -1, value: backend.Trim(doc)}
+	e := &evaluator{root: root}
+
+	nodes, err := e.eval(root, q.segments)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]Match, len(nodes))
+	for i, n := range nodes {
+		var tokens []string
+		n.tokens(&tokens)
+
+		matches[i] = Match{Path: n.path(), Pointer: pointer.Format(tokens...), Value: n.value}
+	}
+
+	return matches, nil
+}
+
+// node is a value of the document, with its location.
+type node struct {
+	parent *node
+	// name is the key of the value in an object, index is its index in an array or -1.
+	name  string
+	index int
+	value []byte
+}
+
+func (n *node) path() string {
+	if n.parent == nil {
+		return "$"
+	}
+
+	if n.index >= 0 {
+		return n.parent.path() + "[" + strconv.Itoa(n.index) + "]"
+	}
+
+	return n.parent.path() + "['" + escapeName(n.name) + "']"
+}
+
+func (n *node) tokens(tokens *[]string) {
+	if n.parent == nil {
+		return
+	}
+
+	n.parent.tokens(tokens)
+
+	if n.index >= 0 {
+		*tokens = append(*tokens, strconv.Itoa(n.index))
+	} else {
+		*tokens = append(*tokens, n.name)
+	}
+}
+
+// escapeName escapes a name of a normalized path.
+func escapeName(name string) string {
+	var b strings.Builder
+
+	for _, r := range name {
+		switch r {
+		case '\'':
+			b.WriteString(`\'`)
+
+		case '\\':
+			b.WriteString(`\\`)
+
+		case '\b':
+			b.WriteString(`\b`)
+
+		case '\f':
+			b.WriteString(`\f`)
+
+		case '\n':
+			b.WriteString(`\n`)
+
+		case '\r':
+			b.WriteString(`\r`)
+
+		case '\t':
+			b.WriteString(`\t`)
+
+		default:
+			if r < 0x20 {
+				b.WriteString(`\u00`)
+				b.WriteByte("0123456789abcdef"[r>>4])
+				b.WriteByte("0123456789abcdef"[r&0xf])
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+type evaluator struct {
+	root *node
+	// regexps caches the regular expressions of match and search.
+	regexps map[string]*regexp.Regexp
+}
+
+func (e *evaluator) eval(start *node, segments []segment) ([]*node, error) {
+	nodes := []*node{start}
+
+	for _, seg := range segments {
+		var next []*node
+
+		for _, n := range nodes {
+			var err error
+
+			if next, err = e.segment(n, seg, next); err != nil {
+				return nil, err
+			}
+		}
+
+		nodes = next
+	}
+
+	return nodes, nil
+}
+
+// segment appends the nodes selected by seg in n to out.
+func (e *evaluator) segment(n *node, seg segment, out []*node) ([]*node, error) {
+	typ := backend.TypeOf(n.value)
+	if typ != backend.ObjectType && typ != backend.ArrayType {
+		return out, nil
+	}
+
+	children, err := childrenOf(n, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	if out, err = e.selectors(typ, children, seg.selectors, out); err != nil {
+		return nil, err
+	}
+
+	if seg.descendant {
+		for _, child := range children {
+			if out, err = e.segment(child, seg, out); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func childrenOf(n *node, typ backend.ValueType) ([]*node, error) {
+	if typ == backend.ObjectType {
+		members, err := backend.Members(n.value)
+		if err != nil {
+			return nil, err
+		}
+
+		children := make([]*node, len(members))
+		for i, m := range members {
+			children[i] = &node{parent: n, name: m.Key, index: -1, value: m.Value}
+		}
+
+		return children, nil
+	}
+
+	elements, err := backend.Elements(n.value)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make([]*node, len(elements))
+	for i, value := range elements {
+		children[i] = &node{parent: n, index: i, value: value}
+	}
+
+	return children, nil
+}
+
+// selectors appends the children selected by the selectors to out.
+func (e *evaluator) selectors(typ backend.ValueType, children []*node, selectors []selector, out []*node) ([]*node, error) {
+	for _, sel := range selectors {
+		switch s := sel.(type) {
+		case nameSelector:
+			if typ != backend.ObjectType {
+				continue
+			}
+
+			for _, child := range children {
+				if child.name == s.name {
+					out = append(out, child)
+				}
+			}
+
+		case wildcardSelector:
+			out = append(out, children...)
+
+		case indexSelector:
+			if typ != backend.ArrayType {
+				continue
+			}
+
+			index := s.index
+			if index < 0 {
+				index += len(children)
+			}
+
+			if index >= 0 && index < len(children) {
+				out = append(out, children[index])
+			}
+
+		case sliceSelector:
+			if typ != backend.ArrayType {
+				continue
+			}
+
+			s.each(len(children), func(i int) { out = append(out, children[i]) })
+
+		case filterSelector:
+			for _, child := range children {
+				ok, err := e.test(s.expr, child)
+				if err != nil {
+					return nil, err
+				}
+
+				if ok {
+					out = append(out, child)
+				}
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// each calls fn with the indices selected by the slice in an array of length n.
+func (s sliceSelector) each(n int, fn func(i int)) {
+	if s.step == 0 {
+		return
+	}
+
+	normalize := func(i int) int {
+		if i < 0 {
+			return n + i
+		}
+
+		return i
+	}
+
+	clamp := func(i, min, max int) int {
+		if i < min {
+			return min
+		} else if i > max {
+			return max
+		}
+
+		return i
+	}
+
+	if s.step > 0 {
+		start, end := 0, n
+		if s.hasStart {
+			start = clamp(normalize(s.start), 0, n)
+		}
+
+		if s.hasEnd {
+			end = clamp(normalize(s.end), 0, n)
+		}
+
+		for i := start; i < end; i += s.step {
+			fn(i)
+		}
+
+		return
+	}
+
+	start, end := n-1, -1
+	if s.hasStart {
+		start = clamp(normalize(s.start), -1, n-1)
+	}
+
+	if s.hasEnd {
+		end = clamp(normalize(s.end), -1, n-1)
+	}
+
+	for i := start; i > end; i += s.step {
+		fn(i)
+	}
+}
+
+// test evaluates the logical expression x with the current node.
+func (e *evaluator) test(x expr, current *node) (bool, error) {
+	switch t := x.(type) {
+	case orExpr:
+		for _, operand := range t {
+			if ok, err := e.test(operand, current); err != nil || ok {
+				return ok, err
+			}
+		}
+
+		return false, nil
+
+	case andExpr:
+		for _, operand := range t {
+			if ok, err := e.test(operand, current); err != nil || !ok {
+				return false, err
+			}
+		}
+
+		return true, nil
+
+	case notExpr:
+		ok, err := e.test(t.expr, current)
+		return !ok, err
+
+	case existExpr:
+		nodes, err := e.query(t.query, current)
+		return len(nodes) > 0, err
+
+	case *funcExpr:
+		return e.match(t, current)
+
+	case compareExpr:
+		left, err := e.value(t.left, current)
+		if err != nil {
+			return false, err
+		}
+
+		right, err := e.value(t.right, current)
+		if err != nil {
+			return false, err
+		}
+
+		return compare(t.op, left, right)
+	}
+
+	return false, nil
+}
+
+func (e *evaluator) query(q *filterQuery, current *node) ([]*node, error) {
+	if q.relative {
+		return e.eval(current, q.segments)
+	}
+
+	return e.eval(e.root, q.segments)
+}
+
+// value evaluates a comparable, nil is returned if it has no value.
+func (e *evaluator) value(x expr, current *node) ([]byte, error) {
+	switch t := x.(type) {
+	case literal:
+		return t.raw, nil
+
+	case *filterQuery:
+		nodes, err := e.query(t, current)
+		if err != nil || len(nodes) != 1 {
+			return nil, err
+		}
+
+		return nodes[0].value, nil
+
+	case *funcExpr:
+		switch t.name {
+		case "length":
+			value, err := e.value(t.args[0], current)
+			if err != nil || value == nil {
+				return nil, err
+			}
+
+			return length(value)
+
+		case "count":
+			nodes, err := e.query(t.args[0].(*filterQuery), current)
+			if err != nil {
+				return nil, err
+			}
+
+			return []byte(strconv.Itoa(len(nodes))), nil
+
+		case "value":
+			nodes, err := e.query(t.args[0].(*filterQuery), current)
+			if err != nil || len(nodes) != 1 {
+				return nil, err
+			}
+
+			return nodes[0].value, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// length returns the number of characters of a string, or the number of values of an array or object.
+func length(value []byte) ([]byte, error) {
+	var n int
+
+	switch backend.TypeOf(value) {
+	case backend.StringType:
+		s, err := backend.Unquote(value)
+		if err != nil {
+			return nil, err
+		}
+
+		n = utf8.RuneCount(s)
+
+	case backend.ArrayType:
+		elements, err := backend.Elements(value)
+		if err != nil {
+			return nil, err
+		}
+
+		n = len(elements)
+
+	case backend.ObjectType:
+		members, err := backend.Members(value)
+		if err != nil {
+			return nil, err
+		}
+
+		n = len(members)
+
+	default:
+		return nil, nil
+	}
+
+	return []byte(strconv.Itoa(n)), nil
+}
+
+// match evaluates match and search, match checks the whole string and search any substring.
+func (e *evaluator) match(f *funcExpr, current *node) (bool, error) {
+	value, err := e.value(f.args[0], current)
+	if err != nil || backend.TypeOf(value) != backend.StringType {
+		return false, err
+	}
+
+	pattern, err := e.value(f.args[1], current)
+	if err != nil || backend.TypeOf(pattern) != backend.StringType {
+		return false, err
+	}
+
+	s, err := backend.Unquote(value)
+	if err != nil {
+		return false, err
+	}
+
+	expr, err := backend.Unquote(pattern)
+	if err != nil {
+		return false, err
+	}
+
+	key := string(expr)
+	if f.name == "match" {
+		key = "^(?:" + key + ")$"
+	}
+
+	re, ok := e.regexps[key]
+	if !ok {
+		// invalid regular expressions match nothing
+		re, _ = regexp.Compile(key)
+
+		if e.regexps == nil {
+			e.regexps = make(map[string]*regexp.Regexp)
+		}
+
+		e.regexps[key] = re
+	}
+
+	return re != nil && re.Match(s), nil
+}
+
+// compare compares the values with op, nil values are only equal to each other.
+func compare(op string, left, right []byte) (bool, error) {
+	switch op {
+	case "==":
+		return equal(left, right)
+
+	case "!=":
+		eq, err := equal(left, right)
+		return !eq, err
+
+	case "<":
+		return less(left, right)
+
+	case ">":
+		return less(right, left)
+
+	case "<=":
+		if lt, err := less(left, right); err != nil || lt {
+			return lt, err
+		}
+
+		return equal(left, right)
+
+	case ">=":
+		if lt, err := less(right, left); err != nil || lt {
+			return lt, err
+		}
+
+		return equal(left, right)
+	}
+
+	return false, nil
+}
+
+func equal(left, right []byte) (bool, error) {
+	if left == nil || right == nil {
+		return left == nil && right == nil, nil
+	}
+
+	return backend.Equal(left, right)
+}
+
+// less reports whether left is less than right, only numbers and strings are ordered.
+func less(left, right []byte) (bool, error) {
+	if left == nil || right == nil {
+		return false, nil
+	}
+
+	typ := backend.TypeOf(left)
+	if typ != backend.TypeOf(right) {
+		return false, nil
+	}
+
+	switch typ {
+	case backend.NumberType:
+		l, lok := new(big.Rat).SetString(string(backend.Trim(left)))
+		r, rok := new(big.Rat).SetString(string(backend.Trim(right)))
+		return lok && rok && l.Cmp(r) < 0, nil
+
+	case backend.StringType:
+		l, err := backend.Unquote(backend.Trim(left))
+		if err != nil {
+			return false, err
+		}
+
+		r, err := backend.Unquote(backend.Trim(right))
+		if err != nil {
+			return false, err
+		}
+
+		return string(l) < string(r), nil
+	}
+
+	return false, nil
+}
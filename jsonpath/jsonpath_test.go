@@ -0,0 +1,198 @@
+package jsonpath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// store is the example document of RFC 9535.
+var store = []byte(`{ "store": {
+	"book": [
+		{ "category": "reference",
+			"author": "Nigel Rees",
+			"title": "Sayings of the Century",
+			"price": 8.95
+		},
+		{ "category": "fiction",
+			"author": "Evelyn Waugh",
+			"title": "Sword of Honour",
+			"price": 12.99
+		},
+		{ "category": "fiction",
+			"author": "Herman Melville",
+			"title": "Moby Dick",
+			"isbn": "0-553-21311-3",
+			"price": 8.99
+		},
+		{ "category": "fiction",
+			"author": "J. R. R. Tolkien",
+			"title": "The Lord of the Rings",
+			"isbn": "0-395-19395-8",
+			"price": 22.99
+		}
+	],
+	"bicycle": {
+		"color": "red",
+		"price": 399
+	}
+} }`)
+
+func values(matches []Match) []string {
+	out := make([]string, len(matches))
+	for i, m := range matches {
+		out[i] = string(m.Value)
+	}
+
+	return out
+}
+
+func paths(matches []Match) []string {
+	out := make([]string, len(matches))
+	for i, m := range matches {
+		out[i] = m.Path
+	}
+
+	return out
+}
+
+func TestCompile(t *testing.T) {
+	valid := []string{
+		"$", "$.a", "$['a', \"b\"]", "$[0, -1, 1:2, ::-1, *]", "$..a", "$..[0]", "$..*", "$.a.b_1.\u00e9",
+		"$[?@.a]", "$[?!@.a && (@.b || $.c)]", "$[?@.a == 'x']", "$[?@.a <= -1.5e3]", "$[?length(@) > 1]",
+		"$[?count(@.*) == 2]", "$[?match(@.a, 'a.*')]", "$[?value(@..a) == null]", "$[ ?@ [ 'a' ] ]",
+		"$['\\u00e9\\ud83d\\ude00\\'']",
+	}
+
+	for _, expr := range valid {
+		q, err := Compile(expr)
+		assert.Nil(t, err, "Err must be nil for %s", expr)
+
+		if q != nil {
+			assert.Equal(t, expr, q.String(), "query must be equal to the value expected")
+		}
+	}
+
+	invalid := []string{
+		"", "a", "$.", "$a", "$.[0]", "$[", "$[0", "$[01]", "$[-0]", "$[9007199254740992]", "$['a]", "$['\\x']",
+		"$['\\ud83d']", "$[?@.a == @..b]", "$[?@.a == @.*]", "$[?length(@.*) == 1]", "$[?count(1) == 1]",
+		"$[?length(@)]", "$[?match(@.a, 'a') == true]", "$[?@.a == true()]", "$[?unknown(@)]", "$[?1]",
+		"$ ", "$[?@.a === 1]", "$[1.0]",
+	}
+
+	for _, expr := range invalid {
+		_, err := Compile(expr)
+		assert.NotNil(t, err, "Err must not be nil for %s", expr)
+	}
+
+	assert.Panics(t, func() { MustCompile("$[") }, "MustCompile must panic for invalid queries")
+}
+
+func TestFind(t *testing.T) {
+	tests := map[string][]string{
+		"$":                                 {string(store)},
+		"$.store.book[*].author":            {`"Nigel Rees"`, `"Evelyn Waugh"`, `"Herman Melville"`, `"J. R. R. Tolkien"`},
+		"$..author":                         {`"Nigel Rees"`, `"Evelyn Waugh"`, `"Herman Melville"`, `"J. R. R. Tolkien"`},
+		"$.store..price":                    {`8.95`, `12.99`, `8.99`, `22.99`, `399`},
+		"$..book[2].author":                 {`"Herman Melville"`},
+		"$..book[2].publisher":              {},
+		"$..book[-1].title":                 {`"The Lord of the Rings"`},
+		"$..book[0,1].title":                {`"Sayings of the Century"`, `"Sword of Honour"`},
+		"$..book[:2].title":                 {`"Sayings of the Century"`, `"Sword of Honour"`},
+		"$..book[?@.isbn].title":            {`"Moby Dick"`, `"The Lord of the Rings"`},
+		"$..book[?@.price<10].title":        {`"Sayings of the Century"`, `"Moby Dick"`},
+		"$.store.bicycle['color', 'price']": {`"red"`, `399`},
+		"$.store.bicycle.*":                 {`"red"`, `399`},
+	}
+
+	for expr, expected := range tests {
+		matches, err := Find(store, expr)
+		assert.Nil(t, err, "Err must be nil for %s", expr)
+		assert.Equal(t, expected, values(matches), "values must be equal to the value expected for %s", expr)
+	}
+
+	_, err := Find([]byte(`{"a":`), "$.a")
+	assert.NotNil(t, err, "Err must not be nil for invalid documents")
+}
+
+func TestFindLocation(t *testing.T) {
+	doc := []byte(`{"a": [{"b'\n": 1}, {"b'\n": 2}], "~/": 3}`)
+
+	matches, err := Find(doc, "$..*")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, []string{"$['a']", "$['~/']", "$['a'][0]", "$['a'][1]", "$['a'][0]['b\\'\\n']", "$['a'][1]['b\\'\\n']"},
+		paths(matches), "paths must be equal to the value expected")
+
+	var pointers []string
+	for _, m := range matches {
+		pointers = append(pointers, m.Pointer)
+	}
+
+	assert.Equal(t, []string{"/a", "/~0~1", "/a/0", "/a/1", "/a/0/b'\n", "/a/1/b'\n"}, pointers,
+		"pointers must be equal to the value expected")
+}
+
+func TestFindSlice(t *testing.T) {
+	doc := []byte(`[0, 1, 2, 3, 4, 5, 6]`)
+
+	tests := map[string][]string{
+		"$[1:3]":              {`1`, `2`},
+		"$[5:]":               {`5`, `6`},
+		"$[1:5:2]":            {`1`, `3`},
+		"$[5:1:-2]":           {`5`, `3`},
+		"$[::-1]":             {`6`, `5`, `4`, `3`, `2`, `1`, `0`},
+		"$[-2:]":              {`5`, `6`},
+		"$[-100:2]":           {`0`, `1`},
+		"$[::0]":              {},
+		"$[7]":                {},
+		"$[-8]":               {},
+		"$[0, 0]":             {`0`, `0`},
+		"$[?@ >= 5]":          {`5`, `6`},
+		"$[?@ != 1 && @ < 3]": {`0`, `2`},
+	}
+
+	for expr, expected := range tests {
+		matches, err := Find(doc, expr)
+		assert.Nil(t, err, "Err must be nil for %s", expr)
+		assert.Equal(t, expected, values(matches), "values must be equal to the value expected for %s", expr)
+	}
+}
+
+func TestFindFilter(t *testing.T) {
+	doc := []byte(`[
+		{"a": "b", "d": "e"},
+		{"a": "b\u00e9", "d": 1.0},
+		{"a": "x", "d": [1, 2]},
+		{"d": {"k": null}},
+		{"a": null}
+	]`)
+
+	tests := map[string][]string{
+		`$[?@.a == 'b'].d`:                 {`"e"`},
+		`$[?@.a != 'b'].d`:                 {`1.0`, `[1, 2]`, `{"k": null}`},
+		`$[?@.d == 1].a`:                   {`"b\u00e9"`},
+		`$[?@.a == null].d`:                {},
+		`$[?@.a == $.missing].d`:           {`{"k": null}`},
+		`$[?@.a > 'b'].a`:                  {`"b\u00e9"`, `"x"`},
+		`$[?!@.a].d`:                       {`{"k": null}`},
+		`$[?@.d.k == null].d`:              {`{"k": null}`},
+		`$[?length(@.a) == 2].a`:           {`"b\u00e9"`},
+		`$[?length(@.d) == 2].a`:           {`"x"`},
+		`$[?count(@.*) == 1]`:              {`{"d": {"k": null}}`, `{"a": null}`},
+		`$[?match(@.a, 'b.?')].a`:          {`"b"`, `"b\u00e9"`},
+		`$[?match(@.a, '.')].a`:            {`"b"`, `"x"`},
+		`$[?search(@.a, '\u00e9')].a`:      {`"b\u00e9"`},
+		`$[?search(@.a, '[')].a`:           {},
+		`$[?value(@..k) == null].d`:        {`{"k": null}`},
+		`$[?@.a == 'b' || @.d == 1].d`:     {`"e"`, `1.0`},
+		`$[?(@.a == 'b' || @.a) && @.d].d`: {`"e"`, `1.0`, `[1, 2]`},
+		`$[?@.d <= 1].d`:                   {`1.0`},
+		`$[?@.d < 'z'].d`:                  {`"e"`},
+	}
+
+	for expr, expected := range tests {
+		matches, err := Find(doc, expr)
+		assert.Nil(t, err, "Err must be nil for %s", expr)
+		assert.Equal(t, expected, values(matches), "values must be equal to the value expected for %s", expr)
+	}
+}
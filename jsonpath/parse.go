@@ -0,0 +1,735 @@
+package jsonpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/go-fish/gojson/backend"
+	"github.com/go-fish/gojson/util"
+)
+
+// maxInt is the largest integer of I-JSON, indices and slices are limited to it.
+const maxInt = 1<<53 - 1
+
+type segment struct {
+	descendant bool
+	selectors  []selector
+}
+
+type selector interface{}
+
+type nameSelector struct {
+	name string
+}
+
+type wildcardSelector struct{}
+
+type indexSelector struct {
+	index int
+}
+
+type sliceSelector struct {
+	start, end       int
+	hasStart, hasEnd bool
+	step             int
+}
+
+type filterSelector struct {
+	expr expr
+}
+
+// expr is an expression of a filter, one of the types below.
+type expr interface{}
+
+type orExpr []expr
+
+type andExpr []expr
+
+type notExpr struct {
+	expr expr
+}
+
+// existExpr tests whether the query selects at least one node.
+type existExpr struct {
+	query *filterQuery
+}
+
+type compareExpr struct {
+	op          string
+	left, right expr
+}
+
+// literal is a json value written in the filter, it is kept encoded.
+type literal struct {
+	raw []byte
+}
+
+type filterQuery struct {
+	relative bool
+	segments []segment
+}
+
+// singular reports whether the query selects at most one node.
+func (q *filterQuery) singular() bool {
+	for _, seg := range q.segments {
+		if seg.descendant || len(seg.selectors) != 1 {
+			return false
+		}
+
+		switch seg.selectors[0].(type) {
+		case nameSelector, indexSelector:
+
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+type resultType uint8
+
+const (
+	valueResult resultType = iota
+	logicalResult
+)
+
+type funcExpr struct {
+	name   string
+	args   []expr
+	result resultType
+}
+
+// functions are the function extensions of RFC 9535, with the number of their arguments and the type of their result.
+var functions = map[string]struct {
+	args   int
+	nodes  bool
+	result resultType
+}{
+	"length": {1, false, valueResult},
+	"count":  {1, true, valueResult},
+	"match":  {2, false, logicalResult},
+	"search": {2, false, logicalResult},
+	"value":  {1, true, valueResult},
+}
+
+type parser struct {
+	expr string
+	pos  int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("Invalid jsonpath %q at pos %d, %s", p.expr, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) peek() byte {
+	if p.pos < len(p.expr) {
+		return p.expr[p.pos]
+	}
+
+	return 0
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.expr) && util.IsSkip(p.expr[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *parser) consume(s string) bool {
+	if strings.HasPrefix(p.expr[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+
+	return false
+}
+
+func (p *parser) query() ([]segment, error) {
+	if !p.consume("$") {
+		return nil, p.errorf("query must start with $")
+	}
+
+	segments, err := p.segments()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.expr) {
+		return nil, p.errorf("unexpected character %q", p.peek())
+	}
+
+	return segments, nil
+}
+
+func (p *parser) segments() ([]segment, error) {
+	var segments []segment
+
+	for {
+		pos := p.pos
+		p.skipSpace()
+
+		if c := p.peek(); c != '.' && c != '[' {
+			p.pos = pos
+			return segments, nil
+		}
+
+		seg, err := p.segment()
+		if err != nil {
+			return nil, err
+		}
+
+		segments = append(segments, seg)
+	}
+}
+
+func (p *parser) segment() (segment, error) {
+	var seg segment
+
+	if p.consume("..") {
+		seg.descendant = true
+	} else if p.consume(".") {
+		// .name or .*
+	} else {
+		selectors, err := p.bracketed()
+		seg.selectors = selectors
+		return seg, err
+	}
+
+	switch p.peek() {
+	case '[':
+		if !seg.descendant {
+			return seg, p.errorf("unexpected character %q", '[')
+		}
+
+		selectors, err := p.bracketed()
+		seg.selectors = selectors
+		return seg, err
+
+	case '*':
+		p.pos++
+		seg.selectors = []selector{wildcardSelector{}}
+		return seg, nil
+
+	default:
+		name, err := p.shorthand()
+		seg.selectors = []selector{nameSelector{name}}
+		return seg, err
+	}
+}
+
+// shorthand parses the name of a member after a dot, eg: .name
+func (p *parser) shorthand() (string, error) {
+	begin := p.pos
+
+	for p.pos < len(p.expr) {
+		c := p.expr[p.pos]
+		if c == '_' || c >= 0x80 || (c|0x20 >= 'a' && c|0x20 <= 'z') || (p.pos > begin && util.IsNumber(c)) {
+			p.pos++
+			continue
+		}
+
+		break
+	}
+
+	if p.pos == begin {
+		return "", p.errorf("missing name")
+	}
+
+	return p.expr[begin:p.pos], nil
+}
+
+func (p *parser) bracketed() ([]selector, error) {
+	p.pos++
+
+	var selectors []selector
+	for {
+		p.skipSpace()
+
+		sel, err := p.selector()
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, sel)
+		p.skipSpace()
+
+		switch p.peek() {
+		case ',':
+			p.pos++
+
+		case ']':
+			p.pos++
+			return selectors, nil
+
+		default:
+			return nil, p.errorf("missing ] of selectors")
+		}
+	}
+}
+
+func (p *parser) selector() (selector, error) {
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		name, err := p.stringLiteral()
+		return nameSelector{name}, err
+
+	case c == '*':
+		p.pos++
+		return wildcardSelector{}, nil
+
+	case c == '?':
+		p.pos++
+		p.skipSpace()
+
+		e, err := p.logicalOr()
+		return filterSelector{e}, err
+
+	case c == '-' || c == ':' || util.IsNumber(c):
+		return p.sliceOrIndex()
+
+	default:
+		return nil, p.errorf("invalid selector")
+	}
+}
+
+func (p *parser) sliceOrIndex() (selector, error) {
+	start, hasStart, err := p.integer()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	if !p.consume(":") {
+		if !hasStart {
+			return nil, p.errorf("invalid selector")
+		}
+
+		return indexSelector{start}, nil
+	}
+
+	s := sliceSelector{start: start, hasStart: hasStart, step: 1}
+
+	p.skipSpace()
+	if s.end, s.hasEnd, err = p.integer(); err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	if p.consume(":") {
+		p.skipSpace()
+
+		step, ok, err := p.integer()
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			s.step = step
+		}
+	}
+
+	return s, nil
+}
+
+// integer parses an optional integer, without leading zeros and in the range of I-JSON.
+func (p *parser) integer() (int, bool, error) {
+	begin := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+
+	digits := p.pos
+	for p.pos < len(p.expr) && util.IsNumber(p.expr[p.pos]) {
+		p.pos++
+	}
+
+	if p.pos == digits {
+		if p.pos > begin {
+			return 0, false, p.errorf("invalid integer")
+		}
+
+		return 0, false, nil
+	}
+
+	text := p.expr[begin:p.pos]
+	if (p.expr[digits] == '0' && p.pos-digits > 1) || text == "-0" {
+		return 0, false, p.errorf("invalid integer %s", text)
+	}
+
+	n, err := strconv.Atoi(text)
+	if err != nil || n > maxInt || n < -maxInt {
+		return 0, false, p.errorf("integer %s out of range", text)
+	}
+
+	return n, true, nil
+}
+
+// stringLiteral parses a string in single or double quotes.
+func (p *parser) stringLiteral() (string, error) {
+	quote := p.expr[p.pos]
+	p.pos++
+
+	var b strings.Builder
+	for p.pos < len(p.expr) {
+		c := p.expr[p.pos]
+
+		switch {
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+
+		case c < 0x20:
+			return "", p.errorf("invalid character in string")
+
+		case c != '\\':
+			b.WriteByte(c)
+			p.pos++
+			continue
+		}
+
+		p.pos++
+		switch c := p.peek(); c {
+		case quote, '\\', '/':
+			b.WriteByte(c)
+
+		case 'b':
+			b.WriteByte('\b')
+
+		case 'f':
+			b.WriteByte('\f')
+
+		case 'n':
+			b.WriteByte('\n')
+
+		case 'r':
+			b.WriteByte('\r')
+
+		case 't':
+			b.WriteByte('\t')
+
+		case 'u':
+			r, err := p.unicode()
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteRune(r)
+			continue
+
+		default:
+			return "", p.errorf("invalid escape in string")
+		}
+
+		p.pos++
+	}
+
+	return "", p.errorf("missing %c of string", quote)
+}
+
+// unicode parses an escape sequence \uXXXX, or a surrogate pair of them, the cursor is at the u.
+func (p *parser) unicode() (rune, error) {
+	r, ok := p.hex()
+	if !ok {
+		return 0, p.errorf("invalid unicode escape")
+	}
+
+	if !utf16.IsSurrogate(r) {
+		return r, nil
+	}
+
+	if r >= 0xdc00 || !p.consume("\\") || p.peek() != 'u' {
+		return 0, p.errorf("invalid surrogate pair")
+	}
+
+	r2, ok := p.hex()
+	if r = utf16.DecodeRune(r, r2); !ok || r == utf8.RuneError {
+		return 0, p.errorf("invalid surrogate pair")
+	}
+
+	return r, nil
+}
+
+// hex parses the 4 hex digits after the u of an escape sequence.
+func (p *parser) hex() (rune, bool) {
+	if p.pos+5 > len(p.expr) {
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(p.expr[p.pos+1:p.pos+5], 16, 16)
+	if err != nil {
+		return 0, false
+	}
+
+	p.pos += 5
+	return rune(n), true
+}
+
+func (p *parser) logicalOr() (expr, error) {
+	return p.logical("||", p.logicalAnd)
+}
+
+func (p *parser) logicalAnd() (expr, error) {
+	return p.logical("&&", p.basic)
+}
+
+// logical parses operands separated by op.
+func (p *parser) logical(op string, operand func() (expr, error)) (expr, error) {
+	var exprs []expr
+
+	for {
+		p.skipSpace()
+
+		e, err := operand()
+		if err != nil {
+			return nil, err
+		}
+
+		exprs = append(exprs, e)
+
+		p.skipSpace()
+		if !p.consume(op) {
+			break
+		}
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+
+	if op == "||" {
+		return orExpr(exprs), nil
+	}
+
+	return andExpr(exprs), nil
+}
+
+func (p *parser) basic() (expr, error) {
+	not := p.consume("!")
+	if not {
+		p.skipSpace()
+	}
+
+	var e expr
+
+	if p.consume("(") {
+		inner, err := p.logicalOr()
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpace()
+		if !p.consume(")") {
+			return nil, p.errorf("missing )")
+		}
+
+		e = inner
+	} else {
+		left, err := p.comparable()
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpace()
+		if op := p.compareOp(); op != "" {
+			if not {
+				return nil, p.errorf("! can not negate a comparison without parentheses")
+			}
+
+			p.skipSpace()
+
+			right, err := p.comparable()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := p.checkComparable(left); err != nil {
+				return nil, err
+			}
+
+			if err := p.checkComparable(right); err != nil {
+				return nil, err
+			}
+
+			return compareExpr{op, left, right}, nil
+		}
+
+		switch l := left.(type) {
+		case *filterQuery:
+			e = existExpr{l}
+
+		case *funcExpr:
+			if l.result != logicalResult {
+				return nil, p.errorf("result of %s must be compared", l.name)
+			}
+
+			e = l
+
+		default:
+			return nil, p.errorf("literal must be compared")
+		}
+	}
+
+	if not {
+		return notExpr{e}, nil
+	}
+
+	return e, nil
+}
+
+func (p *parser) compareOp() string {
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.consume(op) {
+			return op
+		}
+	}
+
+	return ""
+}
+
+// checkComparable checks that e has a single value: a literal, a singular query or a function returning a value.
+func (p *parser) checkComparable(e expr) error {
+	switch x := e.(type) {
+	case *filterQuery:
+		if !x.singular() {
+			return p.errorf("query compared must be singular")
+		}
+
+	case *funcExpr:
+		if x.result != valueResult {
+			return p.errorf("result of %s can not be compared", x.name)
+		}
+	}
+
+	return nil
+}
+
+// comparable parses a literal, a query or a function.
+func (p *parser) comparable() (expr, error) {
+	switch c := p.peek(); {
+	case c == '@' || c == '$':
+		p.pos++
+
+		segments, err := p.segments()
+		if err != nil {
+			return nil, err
+		}
+
+		return &filterQuery{relative: c == '@', segments: segments}, nil
+
+	case c == '\'' || c == '"':
+		s, err := p.stringLiteral()
+		if err != nil {
+			return nil, err
+		}
+
+		enc := backend.NewEncoder()
+		defer enc.Release()
+
+		enc.EncodeString(s)
+		return literal{append([]byte(nil), enc.Bytes()...)}, nil
+
+	case c == '-' || util.IsNumber(c):
+		return p.number()
+
+	case c >= 'a' && c <= 'z':
+		begin := p.pos
+		for p.pos < len(p.expr) {
+			if c := p.expr[p.pos]; (c >= 'a' && c <= 'z') || c == '_' || util.IsNumber(c) {
+				p.pos++
+				continue
+			}
+
+			break
+		}
+
+		name := p.expr[begin:p.pos]
+		if p.peek() == '(' {
+			return p.function(name)
+		}
+
+		switch name {
+		case "true", "false", "null":
+			return literal{[]byte(name)}, nil
+		}
+
+		p.pos = begin
+		return nil, p.errorf("unknown name %s", name)
+
+	default:
+		return nil, p.errorf("invalid expression")
+	}
+}
+
+// number parses a number literal, which is a json number where -0 is allowed.
+func (p *parser) number() (expr, error) {
+	begin := p.pos
+	for p.pos < len(p.expr) {
+		if c := p.expr[p.pos]; util.IsNumber(c) || c == '-' || c == '+' || c == '.' || c == 'e' || c == 'E' {
+			p.pos++
+			continue
+		}
+
+		break
+	}
+
+	raw := []byte(p.expr[begin:p.pos])
+	if err := backend.Validate(raw); err != nil {
+		p.pos = begin
+		return nil, p.errorf("invalid number %s", raw)
+	}
+
+	return literal{raw}, nil
+}
+
+func (p *parser) function(name string) (expr, error) {
+	fn, ok := functions[name]
+	if !ok {
+		return nil, p.errorf("unknown function %s", name)
+	}
+
+	p.pos++
+
+	f := &funcExpr{name: name, result: fn.result}
+	for {
+		p.skipSpace()
+		if len(f.args) == 0 && p.consume(")") {
+			break
+		}
+
+		arg, err := p.comparable()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := arg.(*filterQuery); fn.nodes && !ok {
+			return nil, p.errorf("argument of %s must be a query", name)
+		}
+
+		if !fn.nodes {
+			if err := p.checkComparable(arg); err != nil {
+				return nil, err
+			}
+		}
+
+		f.args = append(f.args, arg)
+
+		p.skipSpace()
+		if p.consume(")") {
+			break
+		}
+
+		if !p.consume(",") {
+			return nil, p.errorf("missing ) of %s", name)
+		}
+	}
+
+	if len(f.args) != fn.args {
+		return nil, p.errorf("%s must have %d arguments", name, fn.args)
+	}
+
+	return f, nil
+}
@@ -0,0 +1,6 @@
+package gojson
+
+import "github.com/go-fish/gojson/backend"
+
+// Number is the literal text of a json number, see backend.Number.
+type Number = backend.Number
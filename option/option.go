@@ -2,10 +2,15 @@ package option
 
 import (
 	"fmt"
-	"go/importer"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"go/types"
 	"os"
 	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
 )
 
 type Mode uint8
@@ -17,6 +22,14 @@ const (
 	None
 )
 
+type NumberMode uint8
+
+const (
+	Float64Number NumberMode = iota
+	UseNumber
+	UseInt64
+)
+
 // Option defines options on files to be convert.
 type Option struct {
 	Input  string
@@ -27,10 +40,39 @@ type Option struct {
 	Unsafe bool
 
 	// Inline used to decied whether we use inline functions in generated code to increase the performance.
-	Inline      bool
+	Inline bool
+
+	// Number used to decied how numbers are decoded into interface{} values.
+	Number NumberMode
+
+	// Enum used to decied whether named integer types with a String method are encoded as their names.
+	Enum bool
+
+	// Naming used to decied how keys of fields without json tags are named, eg: snake, camel, kebab, lower.
+	Naming string
+
+	// Tags used to decied which build tags are set when loading the package, eg: integration,linux.
+	Tags string
+
+	// Types used to decied which types are generated, all exported structs are generated if empty.
+	Types []string
+
+	// Check used to decied whether we only check that the output file is up to date instead of writing it.
+	Check bool
+
+	// Err holds the error of loading the package, the package is not generated if it is set.
+	Err error
+
 	Marshaler   *types.Interface
 	Unmarshaler *types.Interface
 	Pkg         *types.Package
+
+	// Directives holds the //gojson: comments of types in Pkg, indexed by type name and without the //gojson: prefix.
+	Directives map[string][]string
+
+	// Packages holds the options of all packages generated in the same run, indexed by package path,
+	// fields of types in these packages call the generated methods of their types.
+	Packages map[string]*Option
 }
 
 func NewOption() (*Option, error) {
@@ -42,11 +84,16 @@ func NewOption() (*Option, error) {
 	}
 
 	// initialize Marshaler && Unmarshaler
-	pkg, err := importer.For("source", nil).Import("encoding/json")
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedTypes}, "encoding/json")
 	if err != nil {
 		return nil, fmt.Errorf("Failed to initialize Marshaler && Unmarshaler, error: %s", err)
 	}
 
+	if len(pkgs) != 1 || pkgs[0].Types == nil {
+		return nil, fmt.Errorf("Failed to initialize Marshaler && Unmarshaler, error: Not found encoding/json")
+	}
+
+	pkg := pkgs[0].Types
 	for _, name := range pkg.Scope().Names() {
 		obj := pkg.Scope().Lookup(name)
 
@@ -67,12 +114,75 @@ func NewOption() (*Option, error) {
 	return opt, nil
 }
 
+func (m Mode) IsEncode() bool {
+	return m&Encode > 0 || m&All > 0
+}
+
+func (m Mode) IsDecode() bool {
+	return m&Decode > 0 || m&All > 0
+}
+
 func (o *Option) IsEncode() bool {
-	return o.Mode&Encode > 0 || o.Mode&All > 0
+	return o.Mode.IsEncode()
 }
 
 func (o *Option) IsDecode() bool {
-	return o.Mode&Decode > 0 || o.Mode&All > 0
+	return o.Mode.IsDecode()
+}
+
+// TypeMode returns the mode to generate the type with, None means the type is not generated.
+//
+// types listed in Types are generated, otherwise types with a //gojson:generate directive are generated
+// if any, otherwise all exported types. types with a //gojson:skip directive are never generated unless
+// listed in Types, and //gojson:generate encode|decode limits the type to one of encode and decode of Mode,
+// the type is not generated if Mode does not include it.
+func (o *Option) TypeMode(name string) Mode {
+	args, generate := o.Directive(name, "generate")
+
+	if len(o.Types) > 0 {
+		if !o.isType(name) {
+			return None
+		}
+	} else if _, skip := o.Directive(name, "skip"); skip {
+		return None
+	} else if !generate && (o.hasGenerate() || !token.IsExported(name)) {
+		return None
+	}
+
+	if len(args) > 0 {
+		switch {
+		case args[0] == "encode" && o.Mode.IsEncode():
+			return Encode
+
+		case args[0] == "decode" && o.Mode.IsDecode():
+			return Decode
+		}
+
+		return None
+	}
+
+	return o.Mode
+}
+
+func (o *Option) isType(name string) bool {
+	for _, t := range o.Types {
+		if t == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasGenerate reports whether any type opts in with a //gojson:generate directive.
+func (o *Option) hasGenerate() bool {
+	for name := range o.Directives {
+		if _, ok := o.Directive(name, "generate"); ok {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (o *Option) IsMarshaler(v types.Type) bool {
@@ -100,34 +210,327 @@ func (o *Option) IsUnmarshaler(v types.Type) bool {
 }
 
 func (o *Option) ParsePackage() error {
-	err := os.Remove(o.Output)
-	if err != nil && !os.IsNotExist(err) {
+	opts, err := o.ParsePackages(o.Input)
+	if err != nil {
 		return err
 	}
 
-	input := o.Input
+	if len(opts) != 1 {
+		return fmt.Errorf("Found %d packages in %s, need exactly one", len(opts), o.Input)
+	}
+
+	*o = *opts[0]
+	return o.Err
+}
 
-	fi, err := os.Stat(o.Input)
+// ParsePackages loads the packages matched by patterns, eg: ./..., and returns an option for each package
+// with its Input, Output and Pkg set. Packages failed to load are returned with Err set.
+func (o *Option) ParsePackages(patterns ...string) ([]*Option, error) {
+	locals := make([]string, len(patterns))
+	for i, pattern := range patterns {
+		locals[i] = localPattern(pattern)
+	}
+
+	pkgs, err := o.loadPackages(locals)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if !fi.IsDir() {
-		input = filepath.Dir(input)
+	opts := make([]*Option, 0, len(pkgs))
+	outputs := make(map[string]string, len(pkgs))
+
+	for _, pkg := range pkgs {
+		if len(pkg.GoFiles) == 0 {
+			continue
+		}
+
+		opt := *o
+		opt.Input = filepath.Dir(pkg.GoFiles[0])
+		opt.Output = o.outputOf(opt.Input, pkg.Name)
+		opt.Err = opt.parsePackage(pkg)
+
+		if len(pkgs) > 1 && len(o.Types) > 0 && opt.Err == nil && !opt.hasTypes() {
+			continue
+		}
+
+		if path, ok := outputs[opt.Output]; ok {
+			return nil, fmt.Errorf("Packages %s and %s have the same output %s", path, pkg.PkgPath, opt.Output)
+		}
+
+		outputs[opt.Output] = pkg.PkgPath
+		opts = append(opts, &opt)
 	}
 
-	o.Pkg, err = importer.For("source", nil).Import(input)
-	if err != nil {
+	if len(opts) == 0 {
+		return nil, fmt.Errorf("No package found in %s", strings.Join(patterns, " "))
+	}
+
+	generated := make(map[string]*Option, len(opts))
+	for _, opt := range opts {
+		if opt.Err == nil {
+			generated[opt.Pkg.Path()] = opt
+		}
+	}
+
+	for _, opt := range opts {
+		opt.Packages = generated
+	}
+
+	return opts, nil
+}
+
+func (o *Option) parsePackage(pkg *packages.Package) error {
+	// go list also compiles the package for export data, which fails when other files use the
+	// code to be generated, only parse and type errors matter as types are checked from source.
+	// type errors in function bodies are ignored too, they may call the methods not generated yet.
+	for _, err := range pkg.Errors {
+		if pkg.Types == nil || !pkg.Types.Complete() {
+			return err
+		}
+
+		if err.Kind == packages.ListError || (err.Kind == packages.TypeError && inFuncBody(pkg, err.Pos)) {
+			continue
+		}
+
 		return err
 	}
 
+	o.Pkg = pkg.Types
 	if o.Pkg.Scope() == nil || len(o.Pkg.Scope().Names()) == 0 {
 		return fmt.Errorf("No types to generate")
 	}
 
+	return o.parseDirectives(pkg)
+}
+
+// inFuncBody reports whether the position, eg: file.go:12:5, is inside the body of a function.
+func inFuncBody(pkg *packages.Package, pos string) bool {
+	var line, column int
+
+	i := strings.LastIndex(pos, ":")
+	if i < 0 {
+		return false
+	}
+
+	j := strings.LastIndex(pos[:i], ":")
+	if j < 0 {
+		return false
+	}
+
+	if _, err := fmt.Sscanf(pos[j:], ":%d:%d", &line, &column); err != nil {
+		return false
+	}
+
+	filename := pos[:j]
+
+	for _, file := range pkg.Syntax {
+		if pkg.Fset.Position(file.Pos()).Filename != filename {
+			continue
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+
+			begin, end := pkg.Fset.Position(fn.Body.Lbrace), pkg.Fset.Position(fn.Body.Rbrace)
+			if (line > begin.Line || (line == begin.Line && column > begin.Column)) &&
+				(line < end.Line || (line == end.Line && column < end.Column)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hasTypes reports whether the package declares any of Types.
+func (o *Option) hasTypes() bool {
+	for _, name := range o.Types {
+		if o.Pkg.Scope().Lookup(name) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// localPattern turns paths on disk into patterns of go list, eg: pkg/foo.go => ./pkg, import paths are kept.
+func localPattern(pattern string) string {
+	path := strings.TrimSuffix(pattern, "/...")
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return pattern
+	}
+
+	if !fi.IsDir() {
+		pattern = filepath.Dir(path)
+	}
+
+	if !filepath.IsAbs(pattern) && !strings.HasPrefix(pattern, ".") {
+		pattern = "./" + pattern
+	}
+
+	return pattern
+}
+
+// outputOf returns the output file of package, {dir} and {pkg} in Output are replaced with the directory
+// and name of package, and a bare file name is placed in the directory of package.
+func (o *Option) outputOf(dir, name string) string {
+	output := strings.NewReplacer("{dir}", dir, "{pkg}", name).Replace(o.Output)
+	if filepath.Base(output) == output {
+		output = filepath.Join(dir, output)
+	}
+
+	if abs, err := filepath.Abs(output); err == nil {
+		output = abs
+	}
+
+	return output
+}
+
+// isOutput reports whether the file is the output of its package.
+func (o *Option) isOutput(filename string, src []byte) bool {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, src, parser.PackageClauseOnly)
+	if err != nil {
+		return false
+	}
+
+	return o.outputOf(filepath.Dir(filename), file.Name.Name) == filename
+}
+
+// loadPackages loads the packages with go/packages, types declared in test files are only
+// loaded when the output is a test file too.
+func (o *Option) loadPackages(patterns []string) ([]*packages.Package, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedImports |
+			packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Tests: strings.HasSuffix(o.Output, "_test.go"),
+
+		// ignore the declarations of output file which is going to be replaced
+		ParseFile: func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+			if o.isOutput(filename, src) {
+				return parser.ParseFile(fset, filename, src, parser.PackageClauseOnly)
+			}
+
+			return parser.ParseFile(fset, filename, src, parser.AllErrors|parser.ParseComments)
+		},
+	}
+
+	if o.Tags != "" {
+		cfg.BuildFlags = []string{"-tags", o.Tags}
+	}
+
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(pkgs))
+	result := make([]*packages.Package, 0, len(pkgs))
+
+	for _, p := range pkgs {
+		switch p.ID {
+		// prefer the package compiled with its test files, eg: foo [foo.test]
+		case fmt.Sprintf("%s [%s.test]", p.PkgPath, p.PkgPath):
+			if i, ok := index[p.PkgPath]; ok {
+				result[i] = p
+				continue
+			}
+
+		// skip the generated test main package, eg: foo.test
+		case p.PkgPath:
+			if strings.HasSuffix(p.ID, ".test") {
+				continue
+			}
+
+			if _, ok := index[p.PkgPath]; ok {
+				continue
+			}
+
+		default:
+			continue
+		}
+
+		index[p.PkgPath] = len(result)
+		result = append(result, p)
+	}
+
+	return result, nil
+}
+
+func (o *Option) parseDirectives(pkg *packages.Package) error {
+	output := filepath.Base(o.Output)
+
+	o.Directives = make(map[string][]string)
+
+	for _, file := range pkg.Syntax {
+		if filepath.Base(pkg.Fset.Position(file.Pos()).Filename) == output {
+			continue
+		}
+
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+
+				doc := ts.Doc
+				if doc == nil && len(gen.Specs) == 1 {
+					doc = gen.Doc
+				}
+
+				if doc == nil {
+					continue
+				}
+
+				for _, c := range doc.List {
+					if strings.HasPrefix(c.Text, "//gojson:") {
+						o.Directives[ts.Name.Name] = append(o.Directives[ts.Name.Name], strings.TrimSpace(c.Text[len("//gojson:"):]))
+					}
+				}
+			}
+		}
+	}
+
+	return o.checkDirectives()
+}
+
+// checkDirectives checks the arguments of the directives used to select types,
+// the other directives are checked by the generator.
+func (o *Option) checkDirectives() error {
+	for name := range o.Directives {
+		if args, ok := o.Directive(name, "generate"); ok {
+			if len(args) > 1 || (len(args) == 1 && args[0] != "encode" && args[0] != "decode") {
+				return fmt.Errorf("Invalid directive generate of %s, unknown arguments %s", name, strings.Join(args, " "))
+			}
+		}
+
+		if args, ok := o.Directive(name, "skip"); ok && len(args) > 0 {
+			return fmt.Errorf("Invalid directive skip of %s, unknown arguments %s", name, strings.Join(args, " "))
+		}
+	}
+
 	return nil
 }
 
+// Directive returns the arguments of the first //gojson:<name> directive of the type.
+func (o *Option) Directive(typ, name string) ([]string, bool) {
+	for _, d := range o.Directives[typ] {
+		fields := strings.Fields(d)
+		if len(fields) > 0 && fields[0] == name {
+			return fields[1:], true
+		}
+	}
+
+	return nil, false
+}
+
 func (o *Option) IsLocal(pkg *types.Package) bool {
 	return o.Pkg.Name() == pkg.Name() && o.Pkg.Path() == pkg.Path()
 }
@@ -0,0 +1,148 @@
+package option
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePackageTags(t *testing.T) {
+	opt, err := NewOption()
+	assert.Nil(t, err, "Err must be nil")
+
+	opt.Input = "testdata/tags"
+	assert.Nil(t, opt.ParsePackage(), "Err must be nil")
+	assert.NotNil(t, opt.Pkg.Scope().Lookup("Base"), "Base must be loaded")
+	assert.Nil(t, opt.Pkg.Scope().Lookup("Extra"), "Extra must not be loaded without tags")
+	assert.Nil(t, opt.Pkg.Scope().Lookup("Fixture"), "Fixture must not be loaded without test output")
+
+	opt, err = NewOption()
+	assert.Nil(t, err, "Err must be nil")
+
+	opt.Input = "testdata/tags"
+	opt.Tags = "extra"
+	assert.Nil(t, opt.ParsePackage(), "Err must be nil")
+	assert.NotNil(t, opt.Pkg.Scope().Lookup("Extra"), "Extra must be loaded with tags")
+}
+
+func TestParsePackageTestOutput(t *testing.T) {
+	opt, err := NewOption()
+	assert.Nil(t, err, "Err must be nil")
+
+	opt.Input = "testdata/tags/tags.go"
+	opt.Output = "gojson_test.go"
+	assert.Nil(t, opt.ParsePackage(), "Err must be nil")
+	assert.NotNil(t, opt.Pkg.Scope().Lookup("Fixture"), "Fixture must be loaded with test output")
+}
+
+func TestParseDirectives(t *testing.T) {
+	opt, err := NewOption()
+	assert.Nil(t, err, "Err must be nil")
+
+	opt.Input = "testdata/selection"
+	assert.Nil(t, opt.ParsePackage(), "Err must be nil")
+	assert.Equal(t, map[string][]string{
+		"Marked":     {"generate"},
+		"EncodeOnly": {"generate encode"},
+		"DecodeOnly": {"generate decode"},
+		"Skipped":    {"skip"},
+	}, opt.Directives, "directives must be equal to the value expected")
+
+	args, ok := opt.Directive("EncodeOnly", "generate")
+	assert.True(t, ok, "EncodeOnly must have a generate directive")
+	assert.Equal(t, []string{"encode"}, args, "args must be equal to the value expected")
+
+	_, ok = opt.Directive("Plain", "generate")
+	assert.False(t, ok, "Plain must not have a generate directive")
+}
+
+func TestTypeMode(t *testing.T) {
+	opt := &Option{Mode: All, Directives: map[string][]string{
+		"Skipped": {"skip"},
+	}}
+
+	assert.Equal(t, All, opt.TypeMode("Plain"), "exported types must be generated")
+	assert.Equal(t, None, opt.TypeMode("plain"), "unexported types must not be generated")
+	assert.Equal(t, None, opt.TypeMode("Skipped"), "skipped types must not be generated")
+
+	opt.Directives["Marked"] = []string{"generate"}
+	opt.Directives["EncodeOnly"] = []string{"generate encode"}
+	opt.Directives["DecodeOnly"] = []string{"generate decode"}
+
+	assert.Equal(t, All, opt.TypeMode("Marked"), "marked types must be generated")
+	assert.Equal(t, Encode, opt.TypeMode("EncodeOnly"), "mode must be equal to the value expected")
+	assert.Equal(t, Decode, opt.TypeMode("DecodeOnly"), "mode must be equal to the value expected")
+	assert.Equal(t, None, opt.TypeMode("Plain"), "unmarked types must not be generated")
+
+	opt.Types = []string{"Skipped", "Plain", "EncodeOnly"}
+
+	assert.Equal(t, All, opt.TypeMode("Skipped"), "listed types must be generated")
+	assert.Equal(t, All, opt.TypeMode("Plain"), "listed types must be generated")
+	assert.Equal(t, Encode, opt.TypeMode("EncodeOnly"), "mode must be equal to the value expected")
+	assert.Equal(t, None, opt.TypeMode("Marked"), "unlisted types must not be generated")
+
+	opt.Types = nil
+	opt.Mode = Encode
+
+	assert.Equal(t, Encode, opt.TypeMode("Marked"), "mode must be equal to the value expected")
+	assert.Equal(t, Encode, opt.TypeMode("EncodeOnly"), "mode must be equal to the value expected")
+	assert.Equal(t, None, opt.TypeMode("DecodeOnly"), "types out of mode must not be generated")
+}
+
+func TestParseDirectivesInvalid(t *testing.T) {
+	opt, err := NewOption()
+	assert.Nil(t, err, "Err must be nil")
+
+	opt.Input = "testdata/invalid"
+	err = opt.ParsePackage()
+	if assert.NotNil(t, err, "Err must not be nil for unknown arguments") {
+		assert.Contains(t, err.Error(), "encoder", "Err must name the unknown argument")
+	}
+}
+
+func TestParsePackages(t *testing.T) {
+	opt, err := NewOption()
+	assert.Nil(t, err, "Err must be nil")
+
+	opt.Output = "{dir}/{pkg}_json.go"
+	opts, err := opt.ParsePackages("testdata/tags", "testdata/selection")
+	assert.Nil(t, err, "Err must be nil")
+
+	outputs := make(map[string]string)
+	for _, o := range opts {
+		assert.Nil(t, o.Err, "Err must be nil")
+		assert.Equal(t, len(opts), len(o.Packages), "packages must be shared by all options")
+		outputs[o.Pkg.Name()] = o.Output
+	}
+
+	selection, _ := filepath.Abs("testdata/selection/selection_json.go")
+	tags, _ := filepath.Abs("testdata/tags/tags_json.go")
+	assert.Equal(t, map[string]string{"selection": selection, "tags": tags}, outputs, "outputs must be equal to the value expected")
+
+	opt.Types = []string{"Base"}
+	opts, err = opt.ParsePackages("testdata/tags", "testdata/selection")
+	assert.Nil(t, err, "Err must be nil")
+	if assert.Equal(t, 1, len(opts), "only packages declaring the types must be returned") {
+		assert.Equal(t, "tags", opts[0].Pkg.Name(), "package must be equal to the value expected")
+	}
+
+	opt.Types = nil
+	opt.Output = filepath.Join(t.TempDir(), "gojson.generate.go")
+	_, err = opt.ParsePackages("testdata/tags", "testdata/selection")
+	assert.NotNil(t, err, "Err must not be nil for packages with the same output")
+}
+
+func TestOutputOf(t *testing.T) {
+	opt := &Option{Output: "gojson.generate.go"}
+	assert.Equal(t, filepath.Join("/src", "foo", "gojson.generate.go"), opt.outputOf("/src/foo", "foo"),
+		"output must be placed in the directory of package")
+
+	opt.Output = "{dir}/{pkg}_json.go"
+	assert.Equal(t, filepath.Join("/src", "foo", "bar_json.go"), opt.outputOf("/src/foo", "bar"),
+		"output must be equal to the value expected")
+
+	opt.Output = "/out/{pkg}.go"
+	assert.Equal(t, filepath.Join("/out", "bar.go"), opt.outputOf("/src/foo", "bar"),
+		"output must be equal to the value expected")
+}
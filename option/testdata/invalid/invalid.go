@@ -0,0 +1,4 @@
+package invalid
+
+//gojson:generate encoder
+type Marked struct{}
@@ -0,0 +1,17 @@
+package selection
+
+//gojson:generate
+type Marked struct{}
+
+type (
+	//gojson:generate encode
+	EncodeOnly struct{}
+
+	//gojson:generate decode
+	DecodeOnly struct{}
+)
+
+//gojson:skip
+type Skipped struct{}
+
+type Plain struct{}
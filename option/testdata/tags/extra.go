@@ -0,0 +1,8 @@
+//go:build extra
+
+package tags
+
+type Extra struct {
+	Base
+	Value int `json:"value"`
+}
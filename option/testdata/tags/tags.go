@@ -0,0 +1,5 @@
+package tags
+
+type Base struct {
+	Name string `json:"name"`
+}
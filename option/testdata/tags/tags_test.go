@@ -0,0 +1,5 @@
+package tags
+
+type Fixture struct {
+	Base Base `json:"base"`
+}
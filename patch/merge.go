@@ -0,0 +1,161 @@
+package patch
+
+import (
+	"github.com/go-fish/gojson/backend"
+	"github.com/go-fish/gojson/pointer"
+)
+
+// MergePatch returns doc with the json merge patch applied, doc is not modified.
+// members of patch which are null are removed from doc, objects are merged recursively and other values replace
+// the values of doc.
+func MergePatch(doc, patch []byte) ([]byte, error) {
+	if err := backend.Validate(doc); err != nil {
+		return nil, err
+	}
+
+	if err := backend.Validate(patch); err != nil {
+		return nil, err
+	}
+
+	return merge(doc, patch)
+}
+
+func merge(doc, patch []byte) ([]byte, error) {
+	if backend.TypeOf(patch) != backend.ObjectType {
+		return backend.Trim(patch), nil
+	}
+
+	if doc == nil || backend.TypeOf(doc) != backend.ObjectType {
+		doc = []byte("{}")
+	}
+
+	ms, err := backend.Members(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range ms {
+		ptr := pointer.Format(m.Key)
+
+		value, err := pointer.Resolve(doc, ptr)
+		if err != nil {
+			value = nil
+		}
+
+		if backend.TypeOf(m.Value) == backend.NullType {
+			if value != nil {
+				if doc, err = pointer.Remove(doc, ptr); err != nil {
+					return nil, err
+				}
+			}
+
+			continue
+		}
+
+		if value, err = merge(value, m.Value); err != nil {
+			return nil, err
+		}
+
+		if doc, err = pointer.Set(doc, ptr, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+// CreateMergePatch returns the json merge patch which turns a into b, the keys of a which are not in b are
+// removed by null members, so b must not have members which are null for the patch to be exact.
+func CreateMergePatch(a, b []byte) ([]byte, error) {
+	if err := backend.Validate(a); err != nil {
+		return nil, err
+	}
+
+	if err := backend.Validate(b); err != nil {
+		return nil, err
+	}
+
+	return diff(a, b)
+}
+
+// diff returns the merge patch which turns a into b.
+func diff(a, b []byte) ([]byte, error) {
+	if backend.TypeOf(a) != backend.ObjectType || backend.TypeOf(b) != backend.ObjectType {
+		return append([]byte(nil), backend.Trim(b)...), nil
+	}
+
+	ma, err := backend.Members(a)
+	if err != nil {
+		return nil, err
+	}
+
+	mb, err := backend.Members(b)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]byte, len(ma))
+	for _, m := range ma {
+		values[m.Key] = m.Value
+	}
+
+	enc := backend.NewEncoder()
+	defer enc.Release()
+
+	enc.WriteByte('{')
+
+	writeKey := func(key string) {
+		if enc.Len() > 1 {
+			enc.WriteByte(',')
+		}
+
+		enc.EncodeString(key)
+		enc.WriteByte(':')
+	}
+
+	for _, m := range mb {
+		value, ok := values[m.Key]
+		delete(values, m.Key)
+
+		if !ok {
+			writeKey(m.Key)
+			enc.WriteBytes(backend.Trim(m.Value))
+			continue
+		}
+
+		if backend.TypeOf(value) == backend.ObjectType && backend.TypeOf(m.Value) == backend.ObjectType {
+			patch, err := diff(value, m.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			// nested objects are only written if they changed
+			if string(patch) != "{}" {
+				writeKey(m.Key)
+				enc.WriteBytes(patch)
+			}
+
+			continue
+		}
+
+		eq, err := backend.Equal(value, m.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !eq {
+			writeKey(m.Key)
+			enc.WriteBytes(backend.Trim(m.Value))
+		}
+	}
+
+	for _, m := range ma {
+		if _, ok := values[m.Key]; ok {
+			writeKey(m.Key)
+			enc.WriteNull()
+		}
+	}
+
+	enc.WriteByte('}')
+	return append([]byte(nil), enc.Bytes()...), nil
+}
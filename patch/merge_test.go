@@ -0,0 +1,63 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mergeTests are the examples of RFC 7396 appendix A.
+var mergeTests = []struct {
+	doc, patch, expected string
+}{
+	{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+	{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+	{`{"a":"b"}`, `{"a":null}`, `{}`},
+	{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+	{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+	{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+	{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+	{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+	{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+	{`{"a":"b"}`, `["c"]`, `["c"]`},
+	{`{"a":"foo"}`, `null`, `null`},
+	{`{"a":"foo"}`, `"bar"`, `"bar"`},
+	{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+	{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+	{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+}
+
+func TestMergePatch(t *testing.T) {
+	for _, test := range mergeTests {
+		doc, err := MergePatch([]byte(test.doc), []byte(test.patch))
+		assert.Nil(t, err, "Err must be nil for %s", test.patch)
+		assert.True(t, jsonEqual(t, test.expected, string(doc)), "doc must be equal to the value expected for %s, got %s", test.patch, doc)
+	}
+}
+
+func TestMergePatchFormat(t *testing.T) {
+	doc, err := MergePatch([]byte(`{ "b": 1, "a": { "x": 1 } }`), []byte(`{"a": {"y": 2}}`))
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{ "b": 1, "a": { "x": 1,"y":2 } }`, string(doc), "doc must be equal to the value expected")
+}
+
+func TestCreateMergePatch(t *testing.T) {
+	tests := []struct {
+		a, b, expected string
+	}{
+		{`{"a": 1, "b": {"c": 2, "d": 3}, "e": [1]}`, `{"a": 1.0, "b": {"c": 2, "d": 4}, "e": [1, 2], "f": true}`, `{"b":{"d":4},"e":[1, 2],"f":true}`},
+		{`{"a": 1, "b": {"c": 2}}`, `{"b": {"c": 2}}`, `{"a":null}`},
+		{`{"a": 1}`, `{"a": 1}`, `{}`},
+		{`[1]`, `{"a": 1}`, `{"a": 1}`},
+	}
+
+	for _, test := range tests {
+		patch, err := CreateMergePatch([]byte(test.a), []byte(test.b))
+		assert.Nil(t, err, "Err must be nil")
+		assert.Equal(t, test.expected, string(patch), "patch must be equal to the value expected")
+
+		doc, err := MergePatch([]byte(test.a), patch)
+		assert.Nil(t, err, "Err must be nil")
+		assert.True(t, jsonEqual(t, test.b, string(doc)), "doc must be equal to b, got %s", doc)
+	}
+}
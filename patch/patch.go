@@ -0,0 +1,189 @@
+// Package patch applies json patches, RFC 6902, and json merge patches, RFC 7396, to raw documents.
+// documents are patched by splicing the encoded values with the pointer package, without decoding them,
+// so the formatting and the order of keys of the untouched values are kept.
+package patch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-fish/gojson/backend"
+	"github.com/go-fish/gojson/pointer"
+)
+
+// Operation is an operation of a json patch, Value is the raw json value of add, replace and test,
+// From is the source of move and copy.
+type Operation struct {
+	Op    string
+	Path  string
+	From  string
+	Value []byte
+}
+
+// DecodePatch decodes the json patch document data, the values of the operations alias data.
+func DecodePatch(data []byte) ([]Operation, error) {
+	if err := backend.Validate(data); err != nil {
+		return nil, err
+	}
+
+	dec := backend.NewDecoder()
+	dec.Reset(data, backend.WithUnsafe())
+	defer dec.Release()
+
+	if dec.ValueType() != backend.ArrayType {
+		return nil, fmt.Errorf("Invalid json patch, it must be an array of operations")
+	}
+
+	var ops []Operation
+	err := dec.ArrayEach(func(dec *backend.Decoder, index int) error {
+		var op Operation
+		var path bool
+
+		err := dec.ObjectEach(func(key []byte, dec *backend.Decoder) error {
+			var field *string
+
+			switch string(key) {
+			case "op":
+				field = &op.Op
+
+			case "path":
+				field = &op.Path
+				path = true
+
+			case "from":
+				field = &op.From
+
+			case "value":
+				value, err := dec.ReadRaw()
+				op.Value = value
+				return err
+
+			default:
+				return nil
+			}
+
+			raw, err := dec.ReadString()
+			if err != nil {
+				return err
+			}
+
+			value, err := backend.Unquote(raw)
+			*field = string(value)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if op.Op == "" || !path {
+			return fmt.Errorf("Invalid json patch, operation %d must have op and path", index)
+		}
+
+		ops = append(ops, op)
+		return nil
+	})
+
+	return ops, err
+}
+
+// Apply returns doc with the json patch applied, doc is not modified. the operations are applied in order and
+// the first failing operation stops the patch with an error.
+func Apply(doc, patch []byte) ([]byte, error) {
+	ops, err := DecodePatch(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return ApplyOperations(doc, ops)
+}
+
+// ApplyOperations returns a copy of doc with the operations applied, see Apply.
+func ApplyOperations(doc []byte, ops []Operation) ([]byte, error) {
+	for i, op := range ops {
+		var err error
+
+		doc, err = apply(doc, op)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to apply operation %d %s %s, error: %s", i, op.Op, op.Path, err)
+		}
+	}
+
+	return doc, nil
+}
+
+func apply(doc []byte, op Operation) ([]byte, error) {
+	switch op.Op {
+	case "add", "replace", "test":
+		if op.Value == nil {
+			return nil, fmt.Errorf("Missing value")
+		}
+
+	case "move", "copy":
+		if _, err := pointer.Parse(op.From); err != nil {
+			return nil, err
+		}
+	}
+
+	switch op.Op {
+	case "add":
+		return pointer.Insert(doc, op.Path, op.Value)
+
+	case "remove":
+		return pointer.Remove(doc, op.Path)
+
+	case "replace":
+		if _, err := pointer.Resolve(doc, op.Path); err != nil {
+			return nil, err
+		}
+
+		return pointer.Set(doc, op.Path, op.Value)
+
+	case "move":
+		if op.From == op.Path {
+			return doc, nil
+		}
+
+		if strings.HasPrefix(op.Path, op.From+"/") {
+			return nil, fmt.Errorf("Can not move %s into its child %s", op.From, op.Path)
+		}
+
+		value, err := pointer.Resolve(doc, op.From)
+		if err != nil {
+			return nil, err
+		}
+
+		if doc, err = pointer.Remove(doc, op.From); err != nil {
+			return nil, err
+		}
+
+		return pointer.Insert(doc, op.Path, value)
+
+	case "copy":
+		value, err := pointer.Resolve(doc, op.From)
+		if err != nil {
+			return nil, err
+		}
+
+		return pointer.Insert(doc, op.Path, value)
+
+	case "test":
+		value, err := pointer.Resolve(doc, op.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		eq, err := backend.Equal(value, op.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !eq {
+			return nil, fmt.Errorf("Test failed, value is %s", value)
+		}
+
+		return doc, nil
+
+	default:
+		return nil, fmt.Errorf("Unsupported operation %q", op.Op)
+	}
+}
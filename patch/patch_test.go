@@ -0,0 +1,72 @@
+package patch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// jsonEqual reports whether a and b are equal once decoded.
+func jsonEqual(t *testing.T, a, b string) bool {
+	var va, vb interface{}
+	assert.Nil(t, json.Unmarshal([]byte(a), &va), "Err must be nil")
+	assert.Nil(t, json.Unmarshal([]byte(b), &vb), "Err must be nil")
+	return assert.ObjectsAreEqual(va, vb)
+}
+
+// tests are the examples of RFC 6902 appendix A.
+var tests = []struct {
+	doc, patch, expected string
+}{
+	{`{"foo": "bar"}`, `[{"op": "add", "path": "/baz", "value": "qux"}]`, `{"baz": "qux", "foo": "bar"}`},
+	{`{"foo": ["bar", "baz"]}`, `[{"op": "add", "path": "/foo/1", "value": "qux"}]`, `{"foo": ["bar", "qux", "baz"]}`},
+	{`{"baz": "qux", "foo": "bar"}`, `[{"op": "remove", "path": "/baz"}]`, `{"foo": "bar"}`},
+	{`{"foo": ["bar", "qux", "baz"]}`, `[{"op": "remove", "path": "/foo/1"}]`, `{"foo": ["bar", "baz"]}`},
+	{`{"baz": "qux", "foo": "bar"}`, `[{"op": "replace", "path": "/baz", "value": "boo"}]`, `{"baz": "boo", "foo": "bar"}`},
+	{
+		`{"foo": {"bar": "baz", "waldo": "fred"}, "qux": {"corge": "grault"}}`,
+		`[{"op": "move", "from": "/foo/waldo", "path": "/qux/thud"}]`,
+		`{"foo": {"bar": "baz"}, "qux": {"corge": "grault", "thud": "fred"}}`,
+	},
+	{`{"foo": ["all", "grass", "cows", "eat"]}`, `[{"op": "move", "from": "/foo/1", "path": "/foo/3"}]`, `{"foo": ["all", "cows", "eat", "grass"]}`},
+	{`{"baz": "qux", "foo": ["a", 2, "c"]}`, `[{"op": "test", "path": "/baz", "value": "qux"}, {"op": "test", "path": "/foo/1", "value": 2}]`, `{"baz": "qux", "foo": ["a", 2, "c"]}`},
+	{`{"foo": "bar"}`, `[{"op": "add", "path": "/child", "value": {"grandchild": {}}}]`, `{"foo": "bar", "child": {"grandchild": {}}}`},
+	{`{"foo": "bar"}`, `[{"op": "add", "path": "/baz", "value": "qux", "xyz": 123}]`, `{"foo": "bar", "baz": "qux"}`},
+	{`{"/": 9, "~1": 10}`, `[{"op": "test", "path": "/~01", "value": 10}]`, `{"/": 9, "~1": 10}`},
+	{`{"foo": ["bar"]}`, `[{"op": "add", "path": "/foo/-", "value": ["abc", "def"]}]`, `{"foo": ["bar", ["abc", "def"]]}`},
+	{`{"foo": {"a": 1}}`, `[{"op": "copy", "from": "/foo", "path": "/bar"}]`, `{"foo": {"a": 1}, "bar": {"a": 1}}`},
+	{`{"foo": {"a": 1, "b": [1.0, "A"]}}`, `[{"op": "test", "path": "/foo", "value": {"b": [1, "A"], "a": 1e0}}]`, `{"foo": {"a": 1, "b": [1.0, "A"]}}`},
+	{`{"foo": 1}`, `[{"op": "replace", "path": "", "value": [1]}]`, `[1]`},
+}
+
+func TestApply(t *testing.T) {
+	for _, test := range tests {
+		doc, err := Apply([]byte(test.doc), []byte(test.patch))
+		assert.Nil(t, err, "Err must be nil for %s", test.patch)
+		assert.True(t, jsonEqual(t, test.expected, string(doc)), "doc must be equal to the value expected for %s, got %s", test.patch, doc)
+	}
+}
+
+func TestApplyError(t *testing.T) {
+	errors := []struct {
+		doc, patch string
+	}{
+		{`{"foo": "bar"}`, `[{"op": "add", "path": "/baz/bat", "value": "qux"}]`},
+		{`{"foo": ["bar", "baz"]}`, `[{"op": "add", "path": "/foo/3", "value": "qux"}]`},
+		{`{"baz": "qux"}`, `[{"op": "test", "path": "/baz", "value": "bar"}]`},
+		{`{"/": 9, "~1": 10}`, `[{"op": "test", "path": "/~01", "value": "10"}]`},
+		{`{"foo": "bar"}`, `[{"op": "replace", "path": "/baz", "value": "qux"}]`},
+		{`{"foo": "bar"}`, `[{"op": "remove", "path": "/baz"}]`},
+		{`{"foo": {"a": 1}}`, `[{"op": "move", "from": "/foo", "path": "/foo/b"}]`},
+		{`{"foo": "bar"}`, `[{"op": "add", "path": "/baz"}]`},
+		{`{"foo": "bar"}`, `[{"op": "unknown", "path": "/baz"}]`},
+		{`{"foo": "bar"}`, `[{"op": "add", "value": 1}]`},
+		{`{"foo": "bar"}`, `{"op": "add", "path": "/a", "value": 1}`},
+	}
+
+	for _, test := range errors {
+		_, err := Apply([]byte(test.doc), []byte(test.patch))
+		assert.NotNil(t, err, "Err must not be nil for %s", test.patch)
+	}
+}
@@ -0,0 +1,339 @@
+// Package pointer implements json pointers, RFC 6901, over raw documents. Values are resolved with the
+// offsets of the backend scanner, and set or removed by splicing the document, without decoding it.
+package pointer
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-fish/gojson/backend"
+	"github.com/go-fish/gojson/errors"
+	"github.com/go-fish/gojson/util"
+)
+
+var tokenEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+var tokenUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// Parse returns the unescaped reference tokens of the json pointer ptr, eg: /a~1b/0 is [a/b 0].
+func Parse(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+
+	if ptr[0] != '/' {
+		return nil, fmt.Errorf("Invalid json pointer %q, it must be empty or start with /", ptr)
+	}
+
+	tokens := strings.Split(ptr[1:], "/")
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] == '~' && (j+1 >= len(token) || (token[j+1] != '0' && token[j+1] != '1')) {
+				return nil, fmt.Errorf("Invalid json pointer %q, ~ must be followed by 0 or 1", ptr)
+			}
+		}
+
+		tokens[i] = tokenUnescaper.Replace(token)
+	}
+
+	return tokens, nil
+}
+
+// Format returns the json pointer of the reference tokens, eg: [a/b 0] is /a~1b/0.
+func Format(tokens ...string) string {
+	var b strings.Builder
+
+	for _, token := range tokens {
+		b.WriteByte('/')
+		b.WriteString(tokenEscaper.Replace(token))
+	}
+
+	return b.String()
+}
+
+// Resolve returns the raw value at ptr in doc, it aliases doc.
+func Resolve(doc []byte, ptr string) ([]byte, error) {
+	tokens, err := Parse(ptr)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := locate(doc, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if loc.begin < 0 {
+		return nil, errors.NewKeyPathNotFoundError(tokens)
+	}
+
+	return doc[loc.begin:loc.end], nil
+}
+
+// Set returns a copy of doc with the value at ptr replaced by the raw json value.
+// a missing member is added to its object, and a value is appended to an array at the index - or the length of the array,
+// the parent of the value must exist.
+func Set(doc []byte, ptr string, value []byte) ([]byte, error) {
+	tokens, err := Parse(ptr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := backend.Validate(value); err != nil {
+		return nil, err
+	}
+
+	value = bytes.TrimFunc(value, isSpace)
+
+	loc, err := locate(doc, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if loc.begin >= 0 {
+		return splice(doc, loc.begin, loc.end, value), nil
+	}
+
+	token := tokens[len(tokens)-1]
+
+	if loc.parent == backend.ArrayType && token != "-" {
+		if index, ok := arrayIndex(token); !ok || index != loc.length {
+			return nil, errors.NewKeyPathNotFoundError(tokens)
+		}
+	}
+
+	enc := backend.NewEncoder()
+	defer enc.Release()
+
+	if !loc.empty {
+		enc.WriteByte(',')
+	}
+
+	if loc.parent == backend.ObjectType {
+		enc.EncodeString(token)
+		enc.WriteByte(':')
+	}
+
+	enc.WriteBytes(value)
+
+	if loc.empty {
+		return splice(doc, loc.close, loc.close, enc.Bytes()), nil
+	}
+
+	return splice(doc, loc.prev, loc.prev, enc.Bytes()), nil
+}
+
+// Insert returns a copy of doc with the raw json value inserted in an array at ptr, the elements from the index
+// are shifted, the index - or the length of the array appends the value. other values are set as Set does.
+func Insert(doc []byte, ptr string, value []byte) ([]byte, error) {
+	tokens, err := Parse(ptr)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := locate(doc, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if loc.parent != backend.ArrayType || loc.begin < 0 {
+		return Set(doc, ptr, value)
+	}
+
+	if err := backend.Validate(value); err != nil {
+		return nil, err
+	}
+
+	value = bytes.TrimFunc(value, isSpace)
+
+	data := make([]byte, 0, len(value)+1)
+	data = append(append(data, value...), ',')
+
+	return splice(doc, loc.begin, loc.begin, data), nil
+}
+
+// Remove returns a copy of doc without the value at ptr, the root of doc can not be removed.
+func Remove(doc []byte, ptr string) ([]byte, error) {
+	tokens, err := Parse(ptr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("Invalid json pointer %q, the root can not be removed", ptr)
+	}
+
+	loc, err := locate(doc, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if loc.begin < 0 {
+		return nil, errors.NewKeyPathNotFoundError(tokens)
+	}
+
+	// the comma before the value is removed with it, or the comma after the first value
+	if !loc.empty {
+		return splice(doc, loc.prev, loc.end, nil), nil
+	}
+
+	end := skipSpace(doc, loc.end)
+	if end < len(doc) && doc[end] == ',' {
+		return splice(doc, loc.prev, skipSpace(doc, end+1), nil), nil
+	}
+
+	return splice(doc, loc.prev, loc.end, nil), nil
+}
+
+// location is the position of a value in a document.
+type location struct {
+	// begin and end are the offsets of the value, begin is -1 if the value is missing in its parent.
+	begin int
+	end   int
+
+	// parent is the type of the container of the value, NotExist for the root.
+	parent backend.ValueType
+	// prev is the end of the previous value in parent, or the offset after the open delimiter of parent.
+	prev int
+	// empty is set if there is no value before the value in parent.
+	empty bool
+	// close is the offset of the close delimiter of parent, length is the number of values of parent,
+	// they are only set if the value is missing.
+	close  int
+	length int
+}
+
+type stop struct{}
+
+func (stop) Error() string {
+	return "stop"
+}
+
+func locate(doc []byte, tokens []string) (*location, error) {
+	if err := backend.Validate(doc); err != nil {
+		return nil, err
+	}
+
+	dec := backend.NewDecoder()
+	dec.Reset(doc, backend.WithUnsafe())
+	defer dec.Release()
+
+	dec.ValueType()
+	loc := &location{begin: dec.Cursor()}
+
+	for i, token := range tokens {
+		typ := dec.ValueType()
+		*loc = location{begin: -1, parent: typ, prev: dec.Cursor() + 1, empty: true}
+
+		var err error
+
+		switch typ {
+		case backend.ObjectType:
+			err = dec.ObjectEach(func(key []byte, dec *backend.Decoder) error {
+				if matchKey(key, token) {
+					loc.begin = dec.Cursor()
+					return stop{}
+				}
+
+				return loc.skip(dec)
+			})
+
+		case backend.ArrayType:
+			index, ok := arrayIndex(token)
+
+			err = dec.ArrayEach(func(dec *backend.Decoder, n int) error {
+				if ok && n == index {
+					loc.begin = dec.Cursor()
+					return stop{}
+				}
+
+				return loc.skip(dec)
+			})
+
+		default:
+			return nil, errors.NewKeyPathNotFoundError(tokens)
+		}
+
+		if _, ok := err.(stop); !ok && err != nil {
+			return nil, err
+		}
+
+		if loc.begin < 0 {
+			if i < len(tokens)-1 {
+				return nil, errors.NewKeyPathNotFoundError(tokens)
+			}
+
+			loc.close = dec.Cursor() - 1
+			return loc, nil
+		}
+	}
+
+	if err := dec.SkipValue(); err != nil {
+		return nil, err
+	}
+
+	loc.end = dec.Cursor()
+	return loc, nil
+}
+
+// skip skips a value which is not the value located.
+func (loc *location) skip(dec *backend.Decoder) error {
+	if err := dec.SkipValue(); err != nil {
+		return err
+	}
+
+	loc.prev = dec.Cursor()
+	loc.empty = false
+	loc.length++
+	return nil
+}
+
+// arrayIndex returns the index of token, which is 0 or a number without leading zeros.
+func arrayIndex(token string) (int, bool) {
+	if token == "" || (token[0] == '0' && len(token) > 1) {
+		return 0, false
+	}
+
+	for i := 0; i < len(token); i++ {
+		if !util.IsNumber(token[i]) {
+			return 0, false
+		}
+	}
+
+	index, err := strconv.Atoi(token)
+	return index, err == nil
+}
+
+// matchKey reports whether the raw key, without quotes, is token once unescaped.
+func matchKey(key []byte, token string) bool {
+	if bytes.IndexByte(key, '\\') < 0 {
+		return string(key) == token
+	}
+
+	raw := make([]byte, 0, len(key)+2)
+	raw = append(append(append(raw, '"'), key...), '"')
+
+	value, err := backend.Unquote(raw)
+	return err == nil && string(value) == token
+}
+
+// splice returns a copy of doc with the bytes between begin and end replaced by value.
+func splice(doc []byte, begin, end int, value []byte) []byte {
+	data := make([]byte, 0, len(doc)-(end-begin)+len(value))
+	data = append(data, doc[:begin]...)
+	data = append(data, value...)
+	return append(data, doc[end:]...)
+}
+
+func skipSpace(doc []byte, i int) int {
+	for i < len(doc) && util.IsSkip(doc[i]) {
+		i++
+	}
+
+	return i
+}
+
+func isSpace(r rune) bool {
+	return r < 0x80 && util.IsSkip(byte(r))
+}
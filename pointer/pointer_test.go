@@ -0,0 +1,127 @@
+package pointer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-fish/gojson/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// doc is the example document of RFC 6901.
+var doc = []byte(`{
+	"foo": ["bar", "baz"],
+	"": 0,
+	"a/b": 1,
+	"c%d": 2,
+	"e^f": 3,
+	"g|h": 4,
+	"i\\j": 5,
+	"k\"l": 6,
+	" ": 7,
+	"m~n": 8
+}`)
+
+func TestParse(t *testing.T) {
+	tokens, err := Parse("/a~1b/m~0n/0/")
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, []string{"a/b", "m~n", "0", ""}, tokens, "tokens must be equal to the value expected")
+	assert.Equal(t, "/a~1b/m~0n/0/", Format(tokens...), "pointer must be equal to the value expected")
+
+	for _, ptr := range []string{"a", "/a~", "/a~2"} {
+		_, err := Parse(ptr)
+		assert.NotNil(t, err, "Err must not be nil for %s", ptr)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := map[string]string{
+		"":       string(doc),
+		"/foo":   `["bar", "baz"]`,
+		"/foo/0": `"bar"`,
+		"/":      `0`,
+		"/a~1b":  `1`,
+		"/c%d":   `2`,
+		"/e^f":   `3`,
+		"/g|h":   `4`,
+		"/i\\j":  `5`,
+		"/k\"l":  `6`,
+		"/ ":     `7`,
+		"/m~0n":  `8`,
+	}
+
+	for ptr, expected := range tests {
+		value, err := Resolve(doc, ptr)
+		assert.Nil(t, err, "Err must be nil for %s", ptr)
+		assert.Equal(t, expected, string(value), "value must be equal to the value expected for %s", ptr)
+	}
+
+	for _, ptr := range []string{"/missing", "/foo/2", "/foo/-", "/foo/01", "/foo/0/a", "/missing/a"} {
+		_, err := Resolve(doc, ptr)
+		assert.IsType(t, &errors.KeyPathNotFoundError{}, err, "Err must be a KeyPathNotFoundError for %s", ptr)
+	}
+}
+
+func TestSet(t *testing.T) {
+	tests := []struct {
+		doc, ptr, value, expected string
+	}{
+		{`{"a": 1, "b": 2}`, "/a", `{"c": 3}`, `{"a": {"c": 3}, "b": 2}`},
+		{`{"a": 1, "b": 2}`, "/c", ` "x" `, `{"a": 1, "b": 2,"c":"x"}`},
+		{`{}`, "/a~1b", `1`, `{"a/b":1}`},
+		{`{"a": [1, 2]}`, "/a/1", `3`, `{"a": [1, 3]}`},
+		{`{"a": [1, 2]}`, "/a/-", `3`, `{"a": [1, 2,3]}`},
+		{`{"a": [1, 2]}`, "/a/2", `3`, `{"a": [1, 2,3]}`},
+		{`{"a": [ ]}`, "/a/-", `3`, `{"a": [ 3]}`},
+		{`[1]`, "", `{}`, `{}`},
+	}
+
+	for _, test := range tests {
+		data, err := Set([]byte(test.doc), test.ptr, []byte(test.value))
+		assert.Nil(t, err, "Err must be nil for %s", test.ptr)
+		assert.Equal(t, test.expected, string(data), "doc must be equal to the value expected for %s", test.ptr)
+		assert.True(t, json.Valid(data), "doc must be valid")
+	}
+
+	for _, ptr := range []string{"/a/3", "/b/c", "/a/x"} {
+		_, err := Set([]byte(`{"a": [1, 2]}`), ptr, []byte(`1`))
+		assert.NotNil(t, err, "Err must not be nil for %s", ptr)
+	}
+
+	_, err := Set([]byte(`{"a": 1}`), "/a", []byte(`{`))
+	assert.NotNil(t, err, "Err must not be nil for invalid value")
+}
+
+func TestInsert(t *testing.T) {
+	data, err := Insert([]byte(`{"a": [1, 2]}`), "/a/0", []byte(`0`))
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"a": [0,1, 2]}`, string(data), "doc must be equal to the value expected")
+
+	data, err = Insert([]byte(`{"a": [1, 2]}`), "/a/2", []byte(`3`))
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, `{"a": [1, 2,3]}`, string(data), "doc must be equal to the value expected")
+}
+
+func TestRemove(t *testing.T) {
+	tests := []struct {
+		doc, ptr, expected string
+	}{
+		{`{"a": 1, "b": 2, "c": 3}`, "/a", `{"b": 2, "c": 3}`},
+		{`{"a": 1, "b": 2, "c": 3}`, "/b", `{"a": 1, "c": 3}`},
+		{`{"a": 1, "b": 2, "c": 3}`, "/c", `{"a": 1, "b": 2}`},
+		{`{ "a": 1 }`, "/a", `{ }`},
+		{`{"a": [1, [2], 3]}`, "/a/1", `{"a": [1, 3]}`},
+		{`[{"a": 1}]`, "/0/a", `[{}]`},
+	}
+
+	for _, test := range tests {
+		data, err := Remove([]byte(test.doc), test.ptr)
+		assert.Nil(t, err, "Err must be nil for %s", test.ptr)
+		assert.Equal(t, test.expected, string(data), "doc must be equal to the value expected for %s", test.ptr)
+	}
+
+	for _, ptr := range []string{"", "/d", "/a/0"} {
+		_, err := Remove([]byte(`{"a": 1}`), ptr)
+		assert.NotNil(t, err, "Err must not be nil for %s", ptr)
+	}
+}
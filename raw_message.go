@@ -0,0 +1,33 @@
+package gojson
+
+import (
+	"fmt"
+
+	"github.com/go-fish/gojson/backend"
+)
+
+// RawMessage is a raw encoded json value. It can be used to delay decoding
+// of a sub-document or to forward it untouched. Generated code decodes it
+// without copying when the unsafe mode is used.
+type RawMessage []byte
+
+func (m RawMessage) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+
+	if err := backend.Validate(m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *RawMessage) UnmarshalJSON(data []byte) error {
+	if m == nil {
+		return fmt.Errorf("gojson.RawMessage: UnmarshalJSON on nil pointer")
+	}
+
+	*m = append((*m)[0:0], data...)
+	return nil
+}
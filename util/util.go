@@ -326,6 +326,28 @@ func GenerateID(prefix string) string {
 	}
 }
 
+// IDGenerator generates reproducible ids, generators with the same seed yield the same ids in the same order.
+type IDGenerator struct {
+	rand *mathrand.Rand
+}
+
+func NewIDGenerator(seed int64) *IDGenerator {
+	return &IDGenerator{rand: mathrand.New(mathrand.NewSource(seed))}
+}
+
+func (g *IDGenerator) GenerateID(prefix string) string {
+	b := make([]byte, 8)
+	for {
+		g.rand.Read(b)
+		id := hex.EncodeToString(b)
+		if _, err := strconv.ParseInt(id, 10, 64); err == nil {
+			continue
+		}
+
+		return prefix + id
+	}
+}
+
 func init() {
 	// safely set the seed globally so we generate random ids. Tries to use a
 	// crypto seed before falling back to time.